@@ -25,99 +25,261 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
-	"unsafe"
 
 	"github.com/go-spring/gs-assert/internal"
 )
 
+// TestingT is the minimum interface of *testing.T required by assertions.
+// Its optional methods Cleanup, Name, Logf and Failed are detected and used
+// when available, e.g. Logf to log the context of a failure. Skip is never
+// used, as a failed assertion always fails the test rather than skipping it.
+type TestingT = internal.TestingT
+
 // Panic asserts that `fn` panics and the panic message matches `expr`.
 // It reports an error if `fn` does not panic or if the recovered message does not satisfy `expr`.
-func Panic(t internal.TestingT, fn func(), expr string, msg ...string) {
+func Panic(t internal.TestingT, fn func(), expr string, msg ...any) {
 	t.Helper()
 	internal.Panic(t, false, fn, expr, msg...)
 }
 
-// AssertionBase provides common functionality for `Assertion` and `Require`.
+// AssertionBase provides common functionality for all assertion types,
+// such as the test context, the failure mode and the recorded failures.
+// T is the type of the assertion that embeds it, returned by its chained
+// methods, see NewAssertionBase.
+//
+// User-defined assertion types embed it to behave like the built-in ones:
+// their methods call Helper, which is `t.Helper` of the test context, and
+// report failures with Fail, which honours the check and require modes,
+// labels, messages, the language and the reporter.
 type AssertionBase[T any] struct {
+	helper
+	self           T
+	t              internal.TestingT
 	fatalOnFailure bool
+	checkOnly      bool
+	failures       *[]string // shared with the nested assertions, see nested
+	label          string
+	context        []any
+	callers        internal.Callers
+}
+
+// helper is embedded in AssertionBase to promote `t.Helper` as its own
+// method, so that it marks the calling assertion method as a test helper.
+type helper interface {
+	Helper()
+}
+
+// NewAssertionBase creates the AssertionBase of the assertion self, which
+// embeds it, for the given test context, recording the call stack of the
+// assertion constructor. The chained methods of the base, such as Require
+// and As, return self, so the embedding may be anywhere in the assertion:
+//
+//	func ThatOrder(t assert.TestingT, v *Order) *OrderAssertion {
+//		a := &OrderAssertion{v: v}
+//		a.AssertionBase = assert.NewAssertionBase(t, a)
+//		return a
+//	}
+//
+// The source expression of the asserted value is found at the call of the
+// constructor, which must be named That* like the built-in ones.
+func NewAssertionBase[T any](t internal.TestingT, self T) AssertionBase[T] {
+	return AssertionBase[T]{helper: t, self: self, t: t, failures: new([]string), callers: internal.Capture(1)}
 }
 
-// Require is intended for internal use by the `require` package only.
-// IMPORTANT: Do not call it directly!
+// Require switches the assertion to stop the test on failure. It's used by
+// the `require` package, see require.Of for user-defined assertion types.
 func (c *AssertionBase[T]) Require() T {
 	c.fatalOnFailure = true
-	return *(*T)(unsafe.Pointer(&c))
+	return c.self
 }
 
-// ToJsonString converts the given value to a JSON string.
-func ToJsonString(v any) string {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return "error: " + err.Error()
+// Check switches the assertion to check mode. In check mode, failures are
+// only recorded and never reported to the test context, so the result can
+// be inspected with Passed and Failures, e.g. to branch on a condition.
+func (c *AssertionBase[T]) Check() T {
+	c.checkOnly = true
+	return c.self
+}
+
+// As sets the label of the value under assertion, e.g. "order.total".
+// The label is printed at the head of every failure message of the chain.
+// Without a label, the source expression of the value is used if available.
+func (c *AssertionBase[T]) As(label string) T {
+	c.label = label
+	return c.self
+}
+
+// WithContext attaches key/value pairs to the assertion, in the same
+// alternating form as slog, e.g. WithContext("tenant", "acme", "user", 42).
+// The context is printed at the head of every failure message of the chain.
+func (c *AssertionBase[T]) WithContext(keysAndValues ...any) T {
+	c.context = append(c.context, keysAndValues...)
+	return c.self
+}
+
+// nested creates the AssertionBase of an assertion of a value nested in the
+// value of the parent assertion, e.g. a field, an element or the real part of
+// a complex number. It inherits the test context, the failure mode and the
+// context of the parent, and its label extended with the label of the nested
+// value, e.g. ".Total" or "[3]". Its failures are also failures of the parent.
+func nested[P, T any](parent *AssertionBase[P], self T, label string) AssertionBase[T] {
+	parentLabel := parent.label
+	if parentLabel == "" {
+		parentLabel = parent.callers.Expression(internal.TypeName(reflect.TypeFor[P]()))
+	}
+	return AssertionBase[T]{
+		helper:         parent.t,
+		self:           self,
+		t:              parent.t,
+		fatalOnFailure: parent.fatalOnFailure,
+		checkOnly:      parent.checkOnly,
+		failures:       parent.failures,
+		label:          internal.JoinLabel(parentLabel, label),
+		context:        parent.context,
+		callers:        parent.callers,
 	}
-	return string(b)
 }
 
-// ToPrettyString converts the given value to a pretty string.
-func ToPrettyString(v any) string {
-	fv := reflect.ValueOf(v)
-	if v == nil || isNil(fv) {
-		return "nil"
+// Passed returns true if no assertion in the chain has failed so far.
+func (c *AssertionBase[T]) Passed() bool {
+	return c.failures == nil || len(*c.failures) == 0
+}
+
+// Failures returns the descriptions of all failed assertions in the chain.
+func (c *AssertionBase[T]) Failures() []string {
+	if c.failures == nil {
+		return nil
+	}
+	return *c.failures
+}
+
+// Fail records an assertion failure, and reports it to the test context
+// unless the assertion is in check mode. It completes the failure with the
+// name of the calling assertion method, the label or source expression and
+// the context of the value, the user message, the location of the assertion
+// and whether it's fatal, so user-defined assertion methods only describe
+// what failed, e.g.
+//
+//	a.Fail(assert.Failure{Summary: "expected order to be paid, but it is not"}, msg...)
+func (c *AssertionBase[T]) Fail(f Failure, msg ...any) {
+	c.t.Helper()
+	typ := internal.TypeName(reflect.TypeFor[T]())
+	f.Assertion = internal.AssertionName(0)
+	f.Label = c.label
+	if f.Label == "" {
+		f.Label = c.callers.Expression(typ)
+	}
+	f.Context = c.context
+	f.Message = internal.Message(msg...)
+	f.Location = internal.Locate(typ)
+	f.Fatal = c.fatalOnFailure
+	f.Language = internal.ConfigFor(c.t).Language
+	if f.Diff == "" && f.Actual != "" && f.Expected != "" {
+		f.Diff = c.diff(f.Actual, f.Expected)
 	}
+	if c.failures == nil {
+		c.failures = new([]string)
+	}
+	*c.failures = append(*c.failures, f.String())
+	if c.checkOnly {
+		return
+	}
+	internal.ReporterFor(c.t).Report(c.t, &f)
+}
+
+// T returns the test context of the assertion, e.g. for GetConfig in the
+// methods of user-defined assertion types.
+func (c *AssertionBase[T]) T() internal.TestingT {
+	return c.t
+}
+
+// Text returns the failure message of the catalogue key, e.g. "value.nil",
+// in the language configured for the test, formatted with the arguments.
+// It lets user-defined assertion types reuse the built-in messages.
+func (c *AssertionBase[T]) Text(key string, args ...any) string {
+	return internal.Translate(internal.ConfigFor(c.t).Language, key, args...)
+}
 
-	switch fv.Kind() {
-	case reflect.Func:
-		return fmt.Sprintf("(%v)", v)
-	default: // for linter
+// text is Text, as used by the built-in assertion types.
+func (c *AssertionBase[T]) text(key string, args ...any) string {
+	return c.Text(key, args...)
+}
+
+// diff returns the line diff of multi-line actual and expected texts,
+// with the context configured for the test, or "" for single-line texts.
+func (c *AssertionBase[T]) diff(actual, expected string) string {
+	if !strings.Contains(actual, "\n") && !strings.Contains(expected, "\n") {
+		return ""
 	}
+	return internal.Diff(actual, expected, internal.ConfigFor(c.t).DiffContext)
+}
+
+// pretty is ToPrettyString within the limits configured for the test.
+func (c *AssertionBase[T]) pretty(v any) string {
+	cfg := internal.ConfigFor(c.t)
+	return internal.Pretty(v, cfg.PrettyOptions())
+}
 
-	s := fmt.Sprintf("%#v", v)
-	s = strings.TrimLeft(s, "&")
-	s = strings.TrimLeft(s, "*")
-	if strings.HasPrefix(s, "(") {
-		s = s[strings.Index(s, ")")+1:]
+// json is ToJsonString within the limits configured for the test.
+func (c *AssertionBase[T]) json(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return c.pretty(v)
 	}
-	s = strings.TrimSpace(s)
+	return string(b)
+}
 
-	typ := reflect.TypeOf(v).String()
-	typ = strings.TrimLeft(typ, "*")
-	if strings.HasPrefix(s, typ) {
-		return strings.TrimPrefix(s, typ)
+// ToJsonString converts the given value to a JSON string.
+// Values that JSON cannot represent, such as functions, channels or NaN,
+// are converted by ToPrettyString instead.
+func ToJsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ToPrettyString(v)
 	}
-	return s
+	return string(b)
+}
+
+// ToPrettyString converts the given value to a pretty string. It follows
+// pointers, detects cycles, sorts map keys, elides deep, long or large
+// values with "... N more" markers, and prints large values on multiple
+// indented lines.
+func ToPrettyString(v any) string {
+	cfg := internal.GlobalConfig()
+	return internal.Pretty(v, cfg.PrettyOptions())
 }
 
 // Assertion wraps a test context and a value for fluent assertions.
 type Assertion struct {
 	AssertionBase[*Assertion]
-	t internal.TestingT
 	v any
 }
 
 // That creates an Assertion for the given value v and test context t.
 func That(t internal.TestingT, v any) *Assertion {
-	return &Assertion{
-		t: t,
-		v: v,
-	}
+	a := &Assertion{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
 }
 
 // True asserts that got is true. It reports an error if the value is false.
-func (a *Assertion) True(msg ...string) *Assertion {
+func (a *Assertion) True(msg ...any) *Assertion {
 	a.t.Helper()
 	if b, _ := a.v.(bool); !b {
-		str := `expected value to be true, but it is false`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.true"),
+		}, msg...)
 	}
 	return a
 }
 
 // False asserts that got is false. It reports an error if the value is true.
-func (a *Assertion) False(msg ...string) *Assertion {
+func (a *Assertion) False(msg ...any) *Assertion {
 	a.t.Helper()
 	if b, _ := a.v.(bool); b {
-		str := `expected value to be false, but it is true`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.false"),
+		}, msg...)
 	}
 	return a
 }
@@ -138,76 +300,82 @@ func isNil(v reflect.Value) bool {
 }
 
 // Nil asserts that got is nil. It reports an error if the value is not nil.
-func (a *Assertion) Nil(msg ...string) *Assertion {
+func (a *Assertion) Nil(msg ...any) *Assertion {
 	a.t.Helper()
 	// Why can't we use got==nil to judge？Because if
 	// a := (*int)(nil) // %T == *int
 	// b := (any)(nil)  // %T == <nil>
 	// then a==b is false, because they are different types.
 	if !isNil(reflect.ValueOf(a.v)) {
-		str := fmt.Sprintf(`expected value to be nil, but it is not
-  actual: (%T) %s`, a.v, ToPrettyString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.nil"),
+			Actual:  fmt.Sprintf("(%T) %s", a.v, a.pretty(a.v)),
+		}, msg...)
 	}
 	return a
 }
 
 // NotNil asserts that got is not nil. It reports an error if the value is nil.
-func (a *Assertion) NotNil(msg ...string) *Assertion {
+func (a *Assertion) NotNil(msg ...any) *Assertion {
 	a.t.Helper()
 	if isNil(reflect.ValueOf(a.v)) {
-		str := `expected value to be non-nil, but it is nil`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.not_nil"),
+		}, msg...)
 	}
 	return a
 }
 
 // Equal asserts that the wrapped value v is `reflect.DeepEqual` to expect.
 // It reports an error if the values are not deeply equal.
-func (a *Assertion) Equal(expect any, msg ...string) *Assertion {
+func (a *Assertion) Equal(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 	if !reflect.DeepEqual(a.v, expect) {
-		str := fmt.Sprintf(`expected values to be equal, but they are different
-  actual: (%T) %s
-expected: (%T) %s`, a.v, ToPrettyString(a.v), expect, ToPrettyString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("value.equal"),
+			Actual:   fmt.Sprintf("(%T) %s", a.v, a.pretty(a.v)),
+			Expected: fmt.Sprintf("(%T) %s", expect, a.pretty(expect)),
+		}, msg...)
 	}
 	return a
 }
 
 // NotEqual asserts that the wrapped value v is not deeply equal to expect.
 // It reports an error if the values are deeply equal.
-func (a *Assertion) NotEqual(expect any, msg ...string) *Assertion {
+func (a *Assertion) NotEqual(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 	if reflect.DeepEqual(a.v, expect) {
-		str := fmt.Sprintf(`expected values to be different, but they are equal
-  actual: (%T) %s`, a.v, ToPrettyString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.not_equal"),
+			Actual:  fmt.Sprintf("(%T) %s", a.v, a.pretty(a.v)),
+		}, msg...)
 	}
 	return a
 }
 
 // Same asserts that the wrapped value v and expect are the same (using Go ==).
 // It reports an error if v != expect.
-func (a *Assertion) Same(expect any, msg ...string) *Assertion {
+func (a *Assertion) Same(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 	if a.v != expect {
-		str := fmt.Sprintf(`expected values to be same, but they are different
-  actual: (%T) %s
-expected: (%T) %s`, a.v, ToPrettyString(a.v), expect, ToPrettyString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("value.same"),
+			Actual:   fmt.Sprintf("(%T) %s", a.v, a.pretty(a.v)),
+			Expected: fmt.Sprintf("(%T) %s", expect, a.pretty(expect)),
+		}, msg...)
 	}
 	return a
 }
 
 // NotSame asserts that the wrapped value v and expect are not the same (using Go !=).
 // It reports an error if v == expect.
-func (a *Assertion) NotSame(expect any, msg ...string) *Assertion {
+func (a *Assertion) NotSame(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 	if a.v == expect {
-		str := fmt.Sprintf(`expected values to be different, but they are same
-  actual: (%T) %s`, a.v, ToPrettyString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.not_same"),
+			Actual:  fmt.Sprintf("(%T) %s", a.v, a.pretty(a.v)),
+		}, msg...)
 	}
 	return a
 }
@@ -215,7 +383,7 @@ func (a *Assertion) NotSame(expect any, msg ...string) *Assertion {
 // TypeOf asserts that the type of the wrapped value v is assignable to the type of expect.
 // It supports pointer to interface types.
 // It reports an error if the types are not assignable.
-func (a *Assertion) TypeOf(expect any, msg ...string) *Assertion {
+func (a *Assertion) TypeOf(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 
 	e1 := reflect.TypeOf(a.v)
@@ -225,10 +393,11 @@ func (a *Assertion) TypeOf(expect any, msg ...string) *Assertion {
 	}
 
 	if !e1.AssignableTo(e2) {
-		str := fmt.Sprintf(`expected type to be assignable to target, but it does not
-  actual: %s
-expected: %s`, e1.String(), e2.String())
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("value.type_of"),
+			Actual:   e1.String(),
+			Expected: e2.String(),
+		}, msg...)
 	}
 	return a
 }
@@ -236,7 +405,7 @@ expected: %s`, e1.String(), e2.String())
 // Implements asserts that the type of the wrapped value v implements the interface type of expect.
 // The expect parameter must be an interface or pointer to interface.
 // It reports an error if v does not implement the interface.
-func (a *Assertion) Implements(expect any, msg ...string) *Assertion {
+func (a *Assertion) Implements(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 
 	e1 := reflect.TypeOf(a.v)
@@ -245,80 +414,130 @@ func (a *Assertion) Implements(expect any, msg ...string) *Assertion {
 		if e2.Elem().Kind() == reflect.Interface {
 			e2 = e2.Elem()
 		} else {
-			internal.Fail(a.t, a.fatalOnFailure, "expected target to implement should be interface", msg...)
+			a.Fail(internal.Failure{Summary: a.text("value.implements.not_interface")}, msg...)
 			return a
 		}
 	}
 
 	if !e1.Implements(e2) {
-		str := fmt.Sprintf(`expected type to implement target interface, but it does not
-  actual: %s
-expected: %s`, e1.String(), e2.String())
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("value.implements"),
+			Actual:   e1.String(),
+			Expected: e2.String(),
+		}, msg...)
 	}
 	return a
 }
 
 // Has asserts that the wrapped value v has a method named 'Has' that returns true when passed expect.
 // It reports an error if the method does not exist or returns false.
-func (a *Assertion) Has(expect any, msg ...string) *Assertion {
+func (a *Assertion) Has(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 
 	if isNil(reflect.ValueOf(a.v)) {
-		str := `method 'Has' not found on type <nil>`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_found", "Has", "<nil>"),
+		}, msg...)
 		return a
 	}
 
 	m := reflect.ValueOf(a.v).MethodByName("Has")
 	if !m.IsValid() {
-		str := fmt.Sprintf("method 'Has' not found on type %T", a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_found", "Has", fmt.Sprintf("%T", a.v)),
+		}, msg...)
 		return a
 	}
 
 	if m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Bool {
-		str := fmt.Sprintf("method 'Has' on type %T should return only a bool, but it does not", a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_bool", "Has", fmt.Sprintf("%T", a.v)),
+		}, msg...)
 		return a
 	}
 
 	ret := m.Call([]reflect.Value{reflect.ValueOf(expect)})
 	if !ret[0].Bool() {
-		str := fmt.Sprintf(`method 'Has' on type %T should return true when using param %s, but it does not`, a.v, ToPrettyString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.false", "Has", fmt.Sprintf("%T", a.v), a.pretty(expect)),
+		}, msg...)
 	}
 	return a
 }
 
 // Contains asserts that the wrapped value v has a method named 'Contains' that returns true when passed expect.
 // It reports an error if the method does not exist or returns false.
-func (a *Assertion) Contains(expect any, msg ...string) *Assertion {
+func (a *Assertion) Contains(expect any, msg ...any) *Assertion {
 	a.t.Helper()
 
 	if isNil(reflect.ValueOf(a.v)) {
-		str := `method 'Contains' not found on type <nil>`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_found", "Contains", "<nil>"),
+		}, msg...)
 		return a
 	}
 
 	m := reflect.ValueOf(a.v).MethodByName("Contains")
 	if !m.IsValid() {
-		str := fmt.Sprintf("method 'Contains' not found on type %T", a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_found", "Contains", fmt.Sprintf("%T", a.v)),
+		}, msg...)
 		return a
 	}
 
 	if m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Bool {
-		str := fmt.Sprintf("method 'Contains' on type %T should return only a bool, but it does not", a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.not_bool", "Contains", fmt.Sprintf("%T", a.v)),
+		}, msg...)
 		return a
 	}
 
 	ret := m.Call([]reflect.Value{reflect.ValueOf(expect)})
 	if !ret[0].Bool() {
-		str := fmt.Sprintf(`method 'Contains' on type %T should return true when using param %s, but it does not`, a.v, ToPrettyString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("value.method.false", "Contains", fmt.Sprintf("%T", a.v), a.pretty(expect)),
+		}, msg...)
 	}
 	return a
 }
+
+// Field returns an Assertion for the exported field of the struct value, or of
+// the struct it points to, labelled like "order.Total". If there is no such
+// field, it fails, and the returned assertion only records its failures.
+func (a *Assertion) Field(name string) *Assertion {
+	a.t.Helper()
+	f, ok := field(a.v, name)
+	if !ok {
+		key := "value.field.not_found"
+		if a.v != nil && isNil(reflect.ValueOf(a.v)) {
+			key = "value.field.nil"
+		}
+		a.Fail(internal.Failure{Summary: a.text(key, name, fmt.Sprintf("%T", a.v))})
+	}
+	n := &Assertion{v: f}
+	n.AssertionBase = nested(&a.AssertionBase, n, "."+name)
+	n.checkOnly = n.checkOnly || !ok
+	return n
+}
+
+// field returns the value of the exported field of the struct v, or of the
+// struct v points to. It reports false if there is no such field, or if the
+// field can't be reached because of a nil pointer.
+func field(v any, name string) (any, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+	sf, ok := rv.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return nil, false
+	}
+	fv, err := rv.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return nil, false
+	}
+	return fv.Interface(), true
+}
@@ -21,13 +21,24 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/go-spring/gs-assert/assert"
 	"github.com/go-spring/gs-assert/internal"
 )
 
+// TestMain keeps the failure messages checked by the tests independent of
+// the GS_ASSERT_* environment variables.
+func TestMain(m *testing.M) {
+	internal.PinTestEnv()
+	os.Exit(m.Run())
+}
+
 func TestToJsonString(t *testing.T) {
 	// Test basic types
 	assert.ThatString(t, assert.ToJsonString(42)).Equal("42")
@@ -61,14 +72,16 @@ func TestToJsonString(t *testing.T) {
 	assert.ThatString(t, result).Contains(`"two":2`)
 	assert.ThatString(t, result).Matches(`^{.*\}$`)
 
-	// Test with unsupported types
+	// Test with unsupported types and values
 	ch := make(chan int)
 	result = assert.ToJsonString(ch)
-	assert.ThatString(t, result).Equal("error: json: unsupported type: chan int")
+	assert.ThatString(t, result).Matches(`^\(0x.*\)$`)
 
 	fn := func() {}
 	result = assert.ToJsonString(fn)
-	assert.ThatString(t, result).Equal("error: json: unsupported type: func()")
+	assert.ThatString(t, result).Matches(`^\(0x.*\)$`)
+
+	assert.ThatString(t, assert.ToJsonString([]float64{1, math.NaN(), math.Inf(-1)})).Equal("{1, NaN, -Inf}")
 }
 
 func TestToPrettyString(t *testing.T) {
@@ -95,7 +108,7 @@ func TestToPrettyString(t *testing.T) {
 	// Test with nested pointer to struct
 	var pp = &p
 	var ppp = &pp
-	assert.ThatString(t, assert.ToPrettyString(ppp)).Matches(`^\(0x.*\)$`)
+	assert.ThatString(t, assert.ToPrettyString(ppp)).Equal(expected)
 
 	// Test with slice and array
 	s := []int{1, 2, 3}
@@ -146,6 +159,81 @@ func TestToPrettyString(t *testing.T) {
 	type CustomInt int
 	var customInt CustomInt = 42
 	assert.ThatString(t, assert.ToPrettyString(customInt)).Equal("42")
+
+	// Test types whose %#v contains parentheses
+	type Wrapper struct {
+		Fn  func()
+		Ptr *int
+		Any any
+	}
+	i := 7
+	assert.ThatString(t, assert.ToPrettyString(Wrapper{Ptr: &i, Any: 1.5})).Equal("{Fn:nil, Ptr:7, Any:1.5}")
+
+	// Test map keys are sorted
+	assert.ThatString(t, assert.ToPrettyString(map[int]string{10: "c", 2: "b", 1: "a"})).Equal(`{1:"a", 2:"b", 10:"c"}`)
+	assert.ThatString(t, assert.ToPrettyString(map[string]int{"b": 2, "a": 1})).Equal(`{"a":1, "b":2}`)
+}
+
+func TestToPrettyString_Limits(t *testing.T) {
+	// Test cycles
+	type Node struct {
+		Name string
+		Next *Node
+	}
+	n := &Node{Name: "a"}
+	n.Next = &Node{Name: "b", Next: n}
+	assert.ThatString(t, assert.ToPrettyString(n)).Equal(`{Name:"a", Next:{Name:"b", Next:<cycle>}}`)
+
+	s := []any{1, nil}
+	s[1] = s
+	assert.ThatString(t, assert.ToPrettyString(s)).Equal(`{1, <cycle>}`)
+
+	// Test shared but acyclic values are printed in full
+	shared := &Node{Name: "s"}
+	assert.ThatString(t, assert.ToPrettyString([]*Node{shared, shared})).Equal(`{{Name:"s", Next:nil}, {Name:"s", Next:nil}}`)
+
+	// Test depth limit
+	var deep any = 0
+	for range 20 {
+		deep = []any{deep}
+	}
+	assert.ThatString(t, assert.ToPrettyString(deep)).Equal(`{{{{{{{{{{{...}}}}}}}}}}}`)
+
+	// Test element limit
+	assert.ThatString(t, assert.ToPrettyString(make([]int, 220))).HasSuffix(`
+  0,
+  ... 120 more,
+}`)
+
+	// Test string length limit
+	assert.ThatString(t, assert.ToPrettyString(strings.Repeat("a", 1120))).Equal(
+		strconv.Quote(strings.Repeat("a", 1000)) + "... 120 more")
+	assert.ThatString(t, assert.ToPrettyString(strings.Repeat("中", 400))).Equal(
+		strconv.Quote(strings.Repeat("中", 333)) + "... 201 more")
+
+	// Test large values are printed on multiple lines
+	type Address struct {
+		City   string
+		Street string
+	}
+	type User struct {
+		Name    string
+		Tags    []string
+		Address Address
+	}
+	u := User{
+		Name:    "Alice",
+		Tags:    []string{"admin", "dev"},
+		Address: Address{City: "Hangzhou", Street: "a very long street name, which does not fit in one line"},
+	}
+	assert.ThatString(t, assert.ToPrettyString(u)).Equal(`{
+  Name:"Alice",
+  Tags:{"admin", "dev"},
+  Address:{
+    City:"Hangzhou",
+    Street:"a very long street name, which does not fit in one line",
+  },
+}`)
 }
 
 func TestPanic(t *testing.T) {
@@ -175,7 +263,7 @@ func TestPanic(t *testing.T) {
 	m.Reset()
 	assert.Panic(m, func() { panic("there's no error") }, "an error", "index is 0")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: got "there's no error" which does not match "an error"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test panic with different types of values
 	m.Reset()
@@ -209,7 +297,7 @@ func TestThat_True(t *testing.T) {
 	m.Reset()
 	assert.That(m, false).Require().True("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected value to be true, but it is false
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test non-boolean value
 	m.Reset()
@@ -239,7 +327,7 @@ func TestThat_False(t *testing.T) {
 	m.Reset()
 	assert.That(m, true).Require().False("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected value to be false, but it is true
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test non-boolean value (should pass as it's not true)
 	m.Reset()
@@ -282,7 +370,7 @@ func TestThat_Nil(t *testing.T) {
 	assert.That(m, 3).Require().Nil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected value to be nil, but it is not
   actual: (int) 3
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with nil and non-nil pointer
 	m.Reset()
@@ -293,8 +381,8 @@ func TestThat_Nil(t *testing.T) {
 	m.Reset()
 	i := 42
 	assert.That(m, &i).Nil()
-	assert.ThatString(t, m.String()).Matches(`error# Assertion failed: expected value to be nil, but it is not
-  actual: \(\*int\) \(0x.*\)`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: &i: expected value to be nil, but it is not
+  actual: (*int) 42`)
 
 	// Test with nil and non-nil channel
 	m.Reset()
@@ -305,7 +393,7 @@ func TestThat_Nil(t *testing.T) {
 	m.Reset()
 	ch = make(chan int)
 	assert.That(m, ch).Nil()
-	assert.ThatString(t, m.String()).Matches(`error# Assertion failed: expected value to be nil, but it is not
+	assert.ThatString(t, m.String()).Matches(`error# Assertion failed: ch: expected value to be nil, but it is not
   actual: \(chan int\) \(0x.*\)`)
 
 	// Test with nil function
@@ -340,13 +428,13 @@ func TestThat_NotNil(t *testing.T) {
 	m.Reset()
 	assert.That(m, nil).Require().NotNil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected value to be non-nil, but it is nil
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with nil and non-nil pointer
 	m.Reset()
 	var ptr *int
 	assert.That(m, ptr).NotNil()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected value to be non-nil, but it is nil`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: ptr: expected value to be non-nil, but it is nil`)
 
 	m.Reset()
 	i := 42
@@ -357,7 +445,7 @@ func TestThat_NotNil(t *testing.T) {
 	m.Reset()
 	var ch chan int
 	assert.That(m, ch).NotNil()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected value to be non-nil, but it is nil`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: ch: expected value to be non-nil, but it is nil`)
 
 	m.Reset()
 	ch = make(chan int)
@@ -368,7 +456,7 @@ func TestThat_NotNil(t *testing.T) {
 	m.Reset()
 	var fn func()
 	assert.That(m, fn).NotNil()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected value to be non-nil, but it is nil`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: fn: expected value to be non-nil, but it is nil`)
 
 	m.Reset()
 	fn = func() {}
@@ -431,7 +519,7 @@ expected: (string) "0"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected values to be equal, but they are different
   actual: (int) 0
 expected: (string) "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with nested structures
 	m.Reset()
@@ -473,7 +561,7 @@ expected: (int) 0`)
 	var nilSlice []int
 	emptySlice := []int{}
 	assert.That(m, nilSlice).Equal(emptySlice)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be equal, but they are different
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: nilSlice: expected values to be equal, but they are different
   actual: ([]int) nil
 expected: ([]int) {}`)
 
@@ -488,7 +576,7 @@ expected: ([]int) {}`)
 	m.Reset()
 	map3 := map[string]int{"one": 1, "two": 3}
 	assert.That(m, map1).Equal(map3)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be equal, but they are different
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: map1: expected values to be equal, but they are different
   actual: (map[string]int) {"one":1, "two":2}
 expected: (map[string]int) {"one":1, "two":3}`)
 }
@@ -512,7 +600,7 @@ func TestThat_NotEqual(t *testing.T) {
 	assert.That(m, "0").Require().NotEqual("0", "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected values to be different, but they are equal
   actual: (string) "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with structs
 	m.Reset()
@@ -523,7 +611,7 @@ func TestThat_NotEqual(t *testing.T) {
 	p1 := Person{Name: "Alice", Age: 30}
 	p2 := Person{Name: "Alice", Age: 30}
 	assert.That(m, p1).NotEqual(p2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are equal
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p1: expected values to be different, but they are equal
   actual: (assert_test.Person) {Name:"Alice", Age:30}`)
 
 	// Test with slices and maps
@@ -531,14 +619,14 @@ func TestThat_NotEqual(t *testing.T) {
 	s1 := []int{1, 2, 3}
 	s2 := []int{1, 2, 3}
 	assert.That(m, s1).NotEqual(s2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are equal
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: s1: expected values to be different, but they are equal
   actual: ([]int) {1, 2, 3}`)
 
 	m.Reset()
 	map1 := map[string]int{"one": 1, "two": 2}
 	map2 := map[string]int{"one": 1, "two": 2}
 	assert.That(m, map1).NotEqual(map2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are equal
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: map1: expected values to be different, but they are equal
   actual: (map[string]int) {"one":1, "two":2}`)
 
 	// Test with nil values
@@ -573,8 +661,8 @@ func TestThat_NotEqual(t *testing.T) {
 		},
 	}
 	assert.That(m, ns1).NotEqual(ns2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are equal
-  actual: (assert_test.NestedStruct) {ID:1, Data:map[string]interface {}{"name":"test", "values":[]int{1, 2, 3}}}`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: ns1: expected values to be different, but they are equal
+  actual: (assert_test.NestedStruct) {ID:1, Data:{"name":"test", "values":{1, 2, 3}}}`)
 }
 
 func TestThat_Same(t *testing.T) {
@@ -598,7 +686,7 @@ expected: (string) "0"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected values to be same, but they are different
   actual: (int) 0
 expected: (string) "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with pointers - same pointer
 	m.Reset()
@@ -614,7 +702,7 @@ expected: (string) "0"
 	p1 := &Person{Name: "Alice"}
 	p2 := &Person{Name: "Alice"}
 	assert.That(m, p1).Same(p2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be same, but they are different
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p1: expected values to be same, but they are different
   actual: (*assert_test.Person) {Name:"Alice"}
 expected: (*assert_test.Person) {Name:"Alice"}`)
 
@@ -629,7 +717,7 @@ expected: (*assert_test.Person) {Name:"Alice"}`)
 	m.Reset()
 	var nilPtr *int
 	assert.That(m, nilPtr).Same(nil)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be same, but they are different
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: nilPtr: expected values to be same, but they are different
   actual: (*int) nil
 expected: (<nil>) nil`)
 }
@@ -653,7 +741,7 @@ func TestThat_NotSame(t *testing.T) {
 	assert.That(m, "0").Require().NotSame("0", "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected values to be different, but they are same
   actual: (string) "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with pointers - different pointers
 	m.Reset()
@@ -669,7 +757,7 @@ func TestThat_NotSame(t *testing.T) {
 	m.Reset()
 	p := &Person{Name: "Alice"}
 	assert.That(m, p).NotSame(p)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are same
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p: expected values to be different, but they are same
   actual: (*assert_test.Person) {Name:"Alice"}`)
 
 	// Test with nil values
@@ -677,7 +765,7 @@ func TestThat_NotSame(t *testing.T) {
 	var nil1 interface{} = nil
 	var nil2 interface{} = nil
 	assert.That(m, nil1).NotSame(nil2)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected values to be different, but they are same
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: nil1: expected values to be different, but they are same
   actual: (<nil>) nil`)
 }
 
@@ -713,7 +801,7 @@ expected: fmt.Stringer`)
 	m.Reset()
 	s := []int{1, 2, 3}
 	assert.That(m, s).TypeOf((*[]int)(nil))
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected type to be assignable to target, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: s: expected type to be assignable to target, but it does not
   actual: []int
 expected: *[]int`)
 
@@ -731,7 +819,7 @@ expected: *[]string`)
 	}
 	p := Person{Name: "Alice"}
 	assert.That(m, p).TypeOf((*Person)(nil))
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected type to be assignable to target, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p: expected type to be assignable to target, but it does not
   actual: assert_test.Person
 expected: *assert_test.Person`)
 
@@ -765,12 +853,12 @@ func TestThat_Implements(t *testing.T) {
 	// Test non-interface target
 	m.Reset()
 	assert.That(m, new(int)).Implements((*int)(nil))
-	assert.ThatString(t, m.String()).Equal("error# Assertion failed: expected target to implement should be interface")
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: new(int): expected target to implement should be interface")
 
 	// Test type that does not implement interface
 	m.Reset()
 	assert.That(m, new(int)).Require().Implements((*io.Reader)(nil))
-	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected type to implement target interface, but it does not
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: new(int): expected type to implement target interface, but it does not
   actual: *int
 expected: io.Reader`)
 
@@ -784,7 +872,7 @@ expected: io.Reader`)
 	}
 	p := Person{Name: "Alice"}
 	assert.That(m, p).Implements((*Stringer)(nil))
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected type to implement target interface, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p: expected type to implement target interface, but it does not
   actual: assert_test.Person
 expected: assert_test.Stringer`)
 
@@ -804,7 +892,7 @@ expected: assert_test.Stringer`)
 	m.Reset()
 	var buf bytes.Buffer
 	assert.That(m, &buf).Implements((**io.Reader)(nil))
-	assert.ThatString(t, m.String()).Equal("error# Assertion failed: expected target to implement should be interface")
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: &buf: expected target to implement should be interface")
 }
 
 type Node struct{}
@@ -874,7 +962,7 @@ func TestThat_Has(t *testing.T) {
 	m.Reset()
 	var nilTree *Tree
 	assert.That(m, nilTree).Has("1")
-	assert.ThatString(t, m.String()).Equal("error# Assertion failed: method 'Has' not found on type <nil>")
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: nilTree: method 'Has' not found on type <nil>")
 
 	// Test with complex type as parameter
 	m.Reset()
@@ -913,7 +1001,7 @@ func TestThat_Contains(t *testing.T) {
 	m.Reset()
 	var nilTree *Tree
 	assert.That(m, nilTree).Contains("1")
-	assert.ThatString(t, m.String()).Equal("error# Assertion failed: method 'Contains' not found on type <nil>")
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: nilTree: method 'Contains' not found on type <nil>")
 
 	// Test with complex type as parameter
 	m.Reset()
@@ -922,5 +1010,319 @@ func TestThat_Contains(t *testing.T) {
 	}
 	key := ComplexKey{ID: 1, Name: "test"}
 	assert.That(m, container).Contains(key)
-	assert.ThatString(t, m.String()).Equal("error# Assertion failed: method 'Contains' not found on type *assert_test.ComplexContainer")
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: container: method 'Contains' not found on type *assert_test.ComplexContainer")
+}
+
+// versionAssertion is a user-defined assertion type,
+// which doesn't embed AssertionBase as its first field.
+type versionAssertion struct {
+	name string
+	v    string
+	assert.AssertionBase[*versionAssertion]
+}
+
+func ThatVersion(t assert.TestingT, v string) *versionAssertion {
+	a := &versionAssertion{name: "version", v: v}
+	a.AssertionBase = assert.NewAssertionBase(t, a)
+	return a
+}
+
+// IsStable asserts that the version is not a pre-release.
+func (a *versionAssertion) IsStable(msg ...any) *versionAssertion {
+	a.Helper()
+	if strings.Contains(a.v, "-") {
+		a.Fail(assert.Failure{
+			Summary: "expected version to be stable, but it is a pre-release",
+			Actual:  a.v,
+		}, msg...)
+	}
+	return a
+}
+
+func TestNewAssertionBase(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	a := ThatVersion(m, "1.2.3")
+	assert.That(t, a.As("v")).Same(a)
+	assert.That(t, a.WithContext("k", "v")).Same(a)
+	assert.That(t, a.Check()).Same(a)
+	assert.That(t, a.Require()).Same(a)
+	assert.ThatString(t, a.Require().name).Equal("version")
+	assert.ThatString(t, a.Require().v).Equal("1.2.3")
+	assert.That(t, a.Passed()).True()
+	assert.That(t, a.T()).Same(m)
+	assert.ThatString(t, a.Text("value.nil")).Equal("expected value to be nil, but it is not")
+
+	// Test failures of user-defined assertion types
+	var failures []*assert.Failure
+	assert.SetReporter(assert.ReporterFunc(func(t assert.TestingT, f *assert.Failure) {
+		if t != m {
+			assert.TextReporter{}.Report(t, f)
+			return
+		}
+		failures = append(failures, f)
+		assert.TextReporter{}.Report(t, f)
+	}))
+	defer assert.SetReporter(nil)
+
+	m.Reset()
+	version := "1.0.0-rc1"
+	ThatVersion(m, version).IsStable("index %d", 1)
+	l := line() - 1
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: version: expected version to be stable, but it is a pre-release
+  actual: 1.0.0-rc1
+ message: index 1`)
+	assert.ThatSlice(t, failures).Length(1)
+	assert.ThatString(t, failures[0].Assertion).Equal("versionAssertion.IsStable")
+	assert.ThatNumber(t, failures[0].Location.Line).Equal(l)
+	assert.ThatString(t, failures[0].Location.Function).HasSuffix("assert_test.TestNewAssertionBase")
+
+	m.Reset()
+	ThatVersion(m, "1.0.0-rc1").As("v").WithContext("k", 1).Require().IsStable()
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: v [k=1]: expected version to be stable, but it is a pre-release
+  actual: 1.0.0-rc1`)
+
+	m.Reset()
+	c := ThatVersion(m, "1.0.0-rc1").Check().IsStable()
+	assert.That(t, c.Passed()).False()
+	assert.ThatSlice(t, c.Failures()).Equal([]string{`expected version to be stable, but it is a pre-release
+  actual: 1.0.0-rc1`})
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test built-in assertion types
+	m.Reset()
+	s := assert.ThatString(m, "")
+	assert.That(t, s.Require()).Same(s)
+	s.NotBlank()
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be non-blank, but it is blank
+  actual: ""`)
+}
+
+func TestCheck(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	// Test passed check
+	m.Reset()
+	ok := assert.ThatNumber(m, 5).Check().GreaterThan(0).LessThan(10).Passed()
+	assert.That(t, ok).True()
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test failed check is recorded but not reported
+	m.Reset()
+	a := assert.That(m, 1).Check().Equal(2).NotNil().Equal(3, "index is 0")
+	assert.That(t, a.Passed()).False()
+	assert.ThatSlice(t, a.Failures()).Equal([]string{
+		`expected values to be equal, but they are different
+  actual: (int) 1
+expected: (int) 2`,
+		`expected values to be equal, but they are different
+  actual: (int) 1
+expected: (int) 3
+ message: index is 0`,
+	})
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test every assertion type supports check mode
+	m.Reset()
+	assert.That(t, assert.ThatString(m, "abc").Check().HasPrefix("b").Passed()).False()
+	assert.That(t, assert.ThatError(m, nil).Check().NotNil().Passed()).False()
+	assert.That(t, assert.ThatSlice(m, []int{1}).Check().Contains(2).Passed()).False()
+	assert.That(t, assert.ThatMap(m, map[string]int{}).Check().ContainsKey("a").Passed()).False()
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test failures are still recorded when reporting
+	m.Reset()
+	a = assert.That(m, true).False()
+	assert.That(t, a.Passed()).False()
+	assert.ThatSlice(t, a.Failures()).Equal([]string{"expected value to be false, but it is true"})
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: expected value to be false, but it is true")
+}
+
+func TestMessage(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	// Test format string with arguments
+	m.Reset()
+	assert.ThatNumber(m, 5).Equal(10, "user %d in tenant %s", 42, "acme")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 10, but it is 5
+ message: user 42 in tenant acme`)
+
+	// Test plain strings are joined
+	m.Reset()
+	assert.ThatNumber(m, 5).Equal(10, "index is 0", "retry 1")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 10, but it is 5
+ message: index is 0, retry 1`)
+
+	// Test literal '%' is not taken as a format string
+	m.Reset()
+	assert.That(m, false).True("100% sure", "really")
+	assert.ThatNumber(m, 5).Equal(10, "50%d off", 1, 2)
+	assert.ThatNumber(m, 5).Equal(10, "%d%% of %s", 100, "cases")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected value to be true, but it is false
+ message: 100% sure, really` + `error# Assertion failed: expected number to be equal to 10, but it is 5
+ message: 50%d off, 1, 2` + `error# Assertion failed: expected number to be equal to 10, but it is 5
+ message: 100% of cases`)
+
+	// Test lazy message is only evaluated on failure
+	calls := 0
+	lazy := func() string {
+		calls++
+		return "expensive message"
+	}
+	m.Reset()
+	assert.ThatNumber(m, 5).Equal(5, lazy)
+	assert.ThatNumber(t, calls).Equal(0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 5).Equal(10, lazy)
+	assert.ThatNumber(t, calls).Equal(1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 10, but it is 5
+ message: expensive message`)
+
+	// Test lazy arguments of a format string
+	m.Reset()
+	assert.ThatNumber(m, 5).Require().Equal(10, "dump: %s", lazy)
+	assert.ThatNumber(t, calls).Equal(2)
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be equal to 10, but it is 5
+ message: dump: expensive message`)
+}
+
+func TestLabel(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	// Test passed assertion with label
+	m.Reset()
+	assert.ThatNumber(m, 1).As("order.total").GreaterThan(0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test label
+	m.Reset()
+	assert.ThatNumber(m, -1).As("order.total").GreaterThan(0)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: order.total: expected number to be greater than 0, but it is -1`)
+
+	// Test label with context and message
+	m.Reset()
+	assert.ThatString(m, "abc").As("user.name").WithContext("tenant", "acme", "user", 42).Require().Equal("abd", "index is 0")
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: user.name [tenant=acme, user=42]: expected strings to be equal, but they are not
+  actual: "abc"
+expected: "abd"
+ message: index is 0`)
+
+	// Test context without label
+	m.Reset()
+	assert.ThatError(m, nil).WithContext("case", 3).WithContext("odd").NotNil()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: [case=3, !BADKEY=odd]: expected error to be non-nil, but it is nil`)
+
+	// Test label on other assertion types
+	m.Reset()
+	assert.That(m, false).As("flag").True()
+	assert.ThatSlice(m, []int{1}).As("ids").Contains(2)
+	assert.ThatMap(m, map[string]int{}).As("attrs").ContainsKey("a")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: flag: expected value to be true, but it is false` +
+		`error# Assertion failed: ids: expected slice to contain element 2, but it is missing
+  actual: [1]` +
+		`error# Assertion failed: attrs: expected map to contain key 'a', but it is missing
+  actual: {}`)
+}
+
+func TestNestedLabel(t *testing.T) {
+	m := new(internal.MockTestingT)
+	type address struct{ City string }
+	type order struct {
+		Total   int
+		Address *address
+		items   []string
+	}
+	o := &order{Total: 5, Address: &address{City: "Paris"}}
+
+	// Test passed nested assertions
+	m.Reset()
+	assert.That(m, o).Field("Total").Equal(5)
+	assert.That(m, o).Field("Address").Field("City").Equal("Paris")
+	assert.ThatSlice(m, []int{1, 2}).Element(1).Equal(2)
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test nested labels extend the label of the parent
+	m.Reset()
+	assert.That(m, o).As("order").Field("Address").Field("City").Equal("Tokyo")
+	assert.ThatSlice(m, []int{1, 2}).As("ids").WithContext("case", 1).Element(0).Equal(2)
+	assert.That(m, o).Field("Total").Require().Equal(6, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: order.Address.City: expected values to be equal, but they are different
+  actual: (string) "Paris"
+expected: (string) "Tokyo"` + `error# Assertion failed: ids[0] [case=1]: expected values to be equal, but they are different
+  actual: (int) 1
+expected: (int) 2` + `fatal# Assertion failed: o.Total: expected values to be equal, but they are different
+  actual: (int) 5
+expected: (int) 6
+ message: index is 0`)
+
+	// Test missing fields and elements are reported once
+	m.Reset()
+	assert.That(m, o).As("order").Field("items").Equal(nil)
+	assert.That(m, (*order)(nil)).Field("Total").Equal(0)
+	assert.That(m, 3).Field("Total")
+	assert.ThatSlice(m, []int{1, 2}).As("ids").Element(2).Equal(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: order: field 'items' not found on type *assert_test.order` +
+		`error# Assertion failed: field 'Total' not found on nil value of type *assert_test.order` +
+		`error# Assertion failed: field 'Total' not found on type int` +
+		`error# Assertion failed: ids: expected slice to have an element at index 2, but it has length 2
+  actual: [1,2]`)
+
+	// Test failures of nested assertions in check mode
+	m.Reset()
+	s := assert.ThatSlice(m, []int{1, 2}).Check()
+	a := s.Element(0)
+	assert.That(t, a.Equal(2).Passed()).False()
+	assert.That(t, s.Passed()).False()
+	assert.ThatSlice(t, s.Failures()).Equal(a.Failures())
+	assert.ThatString(t, m.String()).Equal("")
+}
+
+func TestExpression(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	type Response struct {
+		Count int
+	}
+	resp := Response{Count: 5}
+
+	// Test expression of the asserted value
+	m.Reset()
+	assert.ThatNumber(m, resp.Count).Equal(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: resp.Count: expected number to be equal to 3, but it is 5`)
+
+	// Test expression of a multi-line chain
+	m.Reset()
+	assert.ThatNumber(m,
+		resp.Count).
+		GreaterThan(0).
+		LessThan(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: resp.Count: expected number to be less than 3, but it is 5`)
+
+	// Test assertions on one line are ambiguous
+	m.Reset()
+	other := 4
+	_ = assert.ThatNumber(m, resp.Count).Equal(5).Passed() && assert.ThatNumber(m, other).Equal(3).Passed()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 3, but it is 4`)
+
+	// Test literal values need no expression
+	m.Reset()
+	assert.ThatNumber(m, 5).Equal(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 3, but it is 5`)
+
+	// Test label takes precedence over expression
+	m.Reset()
+	assert.ThatNumber(m, resp.Count).As("count").Equal(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: count: expected number to be equal to 3, but it is 5`)
+
+	// Test source is not available
+	m.Reset()
+	assertWithoutSource(m, resp.Count)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 3, but it is 5`)
+}
+
+//line missing_source.go:1
+func assertWithoutSource(m *internal.MockTestingT, count int) {
+	assert.ThatNumber(m, count).Equal(3)
 }
@@ -0,0 +1,471 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// BigNumber is the constraint of the arbitrary-precision numbers of math/big.
+type BigNumber[T any] interface {
+	*big.Int | *big.Float | *big.Rat
+	Cmp(y T) int
+	Sign() int
+}
+
+// BigNumberAssertion encapsulates an arbitrary-precision number and a test handler
+// for making assertions on the number. Numbers are compared by their `Cmp` method,
+// not by their internal representation, and printed as decimals in failures.
+type BigNumberAssertion[T BigNumber[T]] struct {
+	AssertionBase[*BigNumberAssertion[T]]
+	v T
+}
+
+// ThatBigInt returns a BigNumberAssertion for the given testing object and big.Int value.
+func ThatBigInt(t internal.TestingT, v *big.Int) *BigNumberAssertion[*big.Int] {
+	a := &BigNumberAssertion[*big.Int]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// ThatBigFloat returns a BigNumberAssertion for the given testing object and big.Float value.
+func ThatBigFloat(t internal.TestingT, v *big.Float) *BigNumberAssertion[*big.Float] {
+	a := &BigNumberAssertion[*big.Float]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// ThatBigRat returns a BigNumberAssertion for the given testing object and big.Rat value.
+func ThatBigRat(t internal.TestingT, v *big.Rat) *BigNumberAssertion[*big.Rat] {
+	a := &BigNumberAssertion[*big.Rat]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// checkNil returns the summary of the failure if the number value or any
+// of the expected values is nil, as nil numbers can't be compared.
+func (a *BigNumberAssertion[T]) checkNil(expect ...T) string {
+	if a.v == nil {
+		return a.text("number.nil")
+	}
+	for _, e := range expect {
+		if e == nil {
+			return a.text("number.nil")
+		}
+	}
+	return ""
+}
+
+// Equal asserts that the number value is equal to the expected value.
+func (a *BigNumberAssertion[T]) Equal(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) != 0 {
+		s = a.text("number.equal", formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotEqual asserts that the number value is not equal to the expected value.
+func (a *BigNumberAssertion[T]) NotEqual(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) == 0 {
+		s = a.text("number.not_equal", formatBig(expect))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// GreaterThan asserts that the number value is greater than the expected value.
+func (a *BigNumberAssertion[T]) GreaterThan(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) <= 0 {
+		s = a.text("number.greater_than", formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// GreaterOrEqual asserts that the number value is greater than or equal to the expected value.
+func (a *BigNumberAssertion[T]) GreaterOrEqual(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) < 0 {
+		s = a.text("number.greater_or_equal", formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// LessThan asserts that the number value is less than the expected value.
+func (a *BigNumberAssertion[T]) LessThan(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) >= 0 {
+		s = a.text("number.less_than", formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// LessOrEqual asserts that the number value is less than or equal to the expected value.
+func (a *BigNumberAssertion[T]) LessOrEqual(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect)
+	if s == "" && a.v.Cmp(expect) > 0 {
+		s = a.text("number.less_or_equal", formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// Zero asserts that the number value is zero.
+func (a *BigNumberAssertion[T]) Zero(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() != 0 {
+		s = a.text("number.zero", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotZero asserts that the number value is not zero.
+func (a *BigNumberAssertion[T]) NotZero(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() == 0 {
+		s = a.text("number.not_zero", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// Positive asserts that the number value is positive.
+func (a *BigNumberAssertion[T]) Positive(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() <= 0 {
+		s = a.text("number.positive", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotPositive asserts that the number value is non-positive.
+func (a *BigNumberAssertion[T]) NotPositive(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() > 0 {
+		s = a.text("number.not_positive", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// Negative asserts that the number value is negative.
+func (a *BigNumberAssertion[T]) Negative(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() >= 0 {
+		s = a.text("number.negative", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotNegative asserts that the number value is non-negative.
+func (a *BigNumberAssertion[T]) NotNegative(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if s == "" && a.v.Sign() < 0 {
+		s = a.text("number.not_negative", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// Between asserts that the number value is between the lower and upper bounds.
+func (a *BigNumberAssertion[T]) Between(lower, upper T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(lower, upper)
+	if s == "" && (a.v.Cmp(lower) < 0 || a.v.Cmp(upper) > 0) {
+		s = a.text("number.between", formatBig(lower), formatBig(upper), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotBetween asserts that the number value is not between the lower and upper bounds.
+func (a *BigNumberAssertion[T]) NotBetween(lower, upper T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(lower, upper)
+	if s == "" && a.v.Cmp(lower) >= 0 && a.v.Cmp(upper) <= 0 {
+		s = a.text("number.not_between", formatBig(lower), formatBig(upper), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// InDelta asserts that the exact difference between the number value and the
+// expected value is at most the absolute value of delta. Infinite floats only
+// match themselves.
+func (a *BigNumberAssertion[T]) InDelta(expect T, delta T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil(expect, delta)
+	if s == "" && !bigInDelta(a.v, expect, delta) {
+		s = a.text("number.in_delta", formatBig(delta), formatBig(expect), formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// InEpsilon asserts that the exact relative error between the number value and
+// the expected value, i.e. |actual-expect| / max(|actual|, |expect|), is at most
+// relErr. Infinite floats only match themselves.
+func (a *BigNumberAssertion[T]) InEpsilon(expect T, relErr float64, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	if s := a.checkNil(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	if a.v.Cmp(expect) == 0 {
+		return a
+	}
+	abs, rel, ok := bigError(a.v, expect)
+	if tol := new(big.Rat); !ok || tol.SetFloat64(relErr) == nil || rel.Cmp(tol) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("number.in_epsilon", strconv.FormatFloat(relErr, 'g', -1, 64), formatBig(expect), formatBig(a.v)),
+			Details: bigErrorDetails(abs, rel, ok),
+		}, msg...)
+	}
+	return a
+}
+
+// ApproxEqual asserts that the number value is approximately equal to the
+// expected value. Floats are compared within a relative tolerance derived from
+// their precision, about 4 units in the last place of the less precise of them.
+// Integers and rationals must be equal.
+func (a *BigNumberAssertion[T]) ApproxEqual(expect T, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	if s := a.checkNil(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	if a.v.Cmp(expect) == 0 {
+		return a
+	}
+	abs, rel, ok := bigError(a.v, expect)
+	if ok {
+		if tol := bigTolerance(a.v, expect); tol != nil && rel.Cmp(tol) <= 0 {
+			return a
+		}
+	}
+	a.Fail(internal.Failure{
+		Summary: a.text("number.approx_equal", formatBig(expect), formatBig(a.v)),
+		Details: bigErrorDetails(abs, rel, ok),
+	}, msg...)
+	return a
+}
+
+// IsInf asserts that the number value is infinite, which only floats can be.
+func (a *BigNumberAssertion[T]) IsInf(sign int, msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if f, ok := any(a.v).(*big.Float); s == "" && (!ok || !f.IsInf() || sign*f.Sign() < 0) {
+		c := "+"
+		if sign < 0 {
+			c = "-"
+		}
+		s = a.text("number.inf", c, formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// IsFinite asserts that the number value is finite, which integers and rationals always are.
+func (a *BigNumberAssertion[T]) IsFinite(msg ...any) *BigNumberAssertion[T] {
+	a.t.Helper()
+	s := a.checkNil()
+	if f, ok := any(a.v).(*big.Float); s == "" && ok && f.IsInf() {
+		s = a.text("number.finite", formatBig(a.v))
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// formatBig formats an arbitrary-precision number as a decimal: integers in
+// full, floats in the shortest form that represents them at their precision,
+// and rationals exactly if they have a finite decimal expansion, otherwise as
+// a fraction followed by its decimal approximation, e.g. "1/3 (≈0.33333333333333333333)".
+func formatBig(v any) string {
+	switch x := v.(type) {
+	case *big.Int:
+		if x != nil {
+			return x.String()
+		}
+	case *big.Float:
+		if x != nil {
+			return x.Text('g', -1)
+		}
+	case *big.Rat:
+		if x != nil {
+			return formatRat(x)
+		}
+	}
+	return "<nil>"
+}
+
+// formatRat formats a rational number as a decimal, see formatBig.
+func formatRat(x *big.Rat) string {
+	if x.IsInt() {
+		return x.Num().String()
+	}
+	if n, exact := x.FloatPrec(); exact {
+		return x.FloatString(n)
+	}
+	approx := strings.TrimRight(x.FloatString(20), "0")
+	return x.String() + " (≈" + approx + ")"
+}
+
+// bigRat returns the exact value of a finite number as a big.Rat,
+// and reports false for infinite floats.
+func bigRat(v any) (*big.Rat, bool) {
+	switch x := v.(type) {
+	case *big.Int:
+		return new(big.Rat).SetInt(x), true
+	case *big.Float:
+		if x.IsInf() {
+			return nil, false
+		}
+		r, _ := x.Rat(nil)
+		return r, true
+	default:
+		return x.(*big.Rat), true
+	}
+}
+
+// bigInDelta reports whether |x-y| <= |delta|. Infinities only match themselves.
+func bigInDelta[T BigNumber[T]](x, y, delta T) bool {
+	if x.Cmp(y) == 0 {
+		return true
+	}
+	rx, okx := bigRat(x)
+	ry, oky := bigRat(y)
+	if !okx || !oky {
+		return false
+	}
+	rd, ok := bigRat(delta)
+	if !ok {
+		return true
+	}
+	diff := new(big.Rat).Sub(rx, ry)
+	return diff.Abs(diff).Cmp(new(big.Rat).Abs(rd)) <= 0
+}
+
+// bigError returns the exact absolute error |x-y| and relative error
+// |x-y| / max(|x|, |y|) of two different numbers. It reports false if any
+// of them is infinite.
+func bigError[T BigNumber[T]](x, y T) (abs, rel *big.Rat, ok bool) {
+	rx, okx := bigRat(x)
+	ry, oky := bigRat(y)
+	if !okx || !oky {
+		return nil, nil, false
+	}
+	abs = new(big.Rat).Sub(rx, ry)
+	abs.Abs(abs)
+	m := new(big.Rat).Abs(rx)
+	if ay := new(big.Rat).Abs(ry); ay.Cmp(m) > 0 {
+		m = ay
+	}
+	return abs, new(big.Rat).Quo(abs, m), true
+}
+
+// bigTolerance returns the relative tolerance of ApproxEqual for floats,
+// 2^(2-prec) for the lower precision of them, i.e. about 4 units in the last
+// place. The precision of a float without one, such as a zero Float, is
+// ignored. It returns nil for integers and rationals, which must be equal.
+func bigTolerance[T BigNumber[T]](x, y T) *big.Rat {
+	fx, ok := any(x).(*big.Float)
+	if !ok {
+		return nil
+	}
+	px, py := fx.Prec(), any(y).(*big.Float).Prec()
+	if px == 0 || (py != 0 && py < px) {
+		px = py
+	}
+	if px == 0 {
+		return nil
+	}
+	return new(big.Rat).SetFrac(big.NewInt(4), new(big.Int).Lsh(big.NewInt(1), uint(px)))
+}
+
+// bigErrorDetails returns the absolute and relative errors as failure
+// details, "+Inf" and "NaN" if they are not defined.
+func bigErrorDetails(abs, rel *big.Rat, ok bool) []internal.Detail {
+	if !ok {
+		return []internal.Detail{
+			{Name: "abs_error", Value: "+Inf"},
+			{Name: "rel_error", Value: "NaN"},
+		}
+	}
+	return []internal.Detail{
+		{Name: "abs_error", Value: new(big.Float).SetRat(abs).Text('g', 10)},
+		{Name: "rel_error", Value: new(big.Float).SetRat(rel).Text('g', 10)},
+	}
+}
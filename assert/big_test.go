@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// bigInt parses a big.Int in base 10.
+func bigInt(s string) *big.Int {
+	x, _ := new(big.Int).SetString(s, 10)
+	return x
+}
+
+func TestBigInt(t *testing.T) {
+	m := new(internal.MockTestingT)
+	x := bigInt("123456789012345678901234567890")
+
+	m.Reset()
+	assert.ThatBigInt(m, x).
+		Equal(bigInt("123456789012345678901234567890")).
+		NotEqual(bigInt("123456789012345678901234567891")).
+		GreaterThan(big.NewInt(math.MaxInt64)).
+		GreaterOrEqual(x).
+		LessThan(bigInt("1000000000000000000000000000000")).
+		LessOrEqual(x).
+		NotZero().
+		Positive().
+		NotNegative().
+		Between(big.NewInt(0), x).
+		NotBetween(big.NewInt(-1), big.NewInt(1)).
+		InDelta(bigInt("123456789012345678901234567880"), big.NewInt(-10)).
+		InEpsilon(bigInt("123456789012345678901234567891"), 1e-29).
+		ApproxEqual(x).
+		IsFinite()
+	assert.ThatBigInt(m, new(big.Int)).Zero().NotPositive().NotNegative()
+	assert.ThatBigInt(m, big.NewInt(-1)).Negative().NotPositive()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatBigInt(m, x).Equal(big.NewInt(1))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: x: expected number to be equal to 1, but it is 123456789012345678901234567890`)
+
+	m.Reset()
+	assert.ThatBigInt(m, x).Require().LessThan(x, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: x: expected number to be less than 123456789012345678901234567890, but it is 123456789012345678901234567890
+ message: index is 0`)
+
+	m.Reset()
+	assert.ThatBigInt(m, x).InDelta(big.NewInt(0), big.NewInt(1)).Negative().IsInf(1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: x: expected number to be within ±1 of 0, but it is 123456789012345678901234567890` +
+		`error# Assertion failed: x: expected number to be negative, but it is 123456789012345678901234567890` +
+		`error# Assertion failed: x: expected number to be +Inf, but it is 123456789012345678901234567890`)
+
+	m.Reset()
+	assert.ThatBigInt(m, x).ApproxEqual(new(big.Int).Add(x, big.NewInt(1)))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: x: expected number to be approximately equal to 123456789012345678901234567891, but it is 123456789012345678901234567890
+ abs err: 1
+ rel err: 8.100000073e-30`)
+
+	// Test nil numbers
+	m.Reset()
+	var y *big.Int
+	assert.ThatBigInt(m, y).Zero()
+	assert.ThatBigInt(m, x).Equal(nil)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: y: cannot compare nil numbers` +
+		`error# Assertion failed: x: cannot compare nil numbers`)
+
+	m.Reset()
+	assert.ThatBigInt(m, y).Between(big.NewInt(0), big.NewInt(1))
+	assert.ThatBigInt(m, y).NotBetween(big.NewInt(0), big.NewInt(1))
+	assert.ThatBigInt(m, x).Between(nil, x)
+	assert.ThatBigInt(m, x).NotBetween(big.NewInt(0), nil)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: y: cannot compare nil numbers` +
+		`error# Assertion failed: y: cannot compare nil numbers` +
+		`error# Assertion failed: x: cannot compare nil numbers` +
+		`error# Assertion failed: x: cannot compare nil numbers`)
+}
+
+func TestBigFloat(t *testing.T) {
+	m := new(internal.MockTestingT)
+	x := new(big.Float).SetPrec(200).SetFloat64(1)
+	x.Quo(x, big.NewFloat(3))
+
+	m.Reset()
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), new(big.Float).SetPrec(200).SetInt64(3))
+	assert.ThatBigFloat(m, x).
+		Equal(third).
+		GreaterThan(big.NewFloat(1.0/3)).
+		Between(big.NewFloat(0.3), big.NewFloat(0.4)).
+		InDelta(big.NewFloat(0.3333), big.NewFloat(1e-4)).
+		InEpsilon(big.NewFloat(1.0/3), 1e-16).
+		ApproxEqual(big.NewFloat(1.0 / 3)).
+		IsFinite()
+	assert.ThatBigFloat(m, big.NewFloat(math.Inf(-1))).IsInf(-1).IsInf(0).InDelta(big.NewFloat(math.Inf(-1)), big.NewFloat(0))
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test the tolerance of ApproxEqual depends on the precision
+	m.Reset()
+	assert.ThatBigFloat(m, x).ApproxEqual(new(big.Float).SetPrec(100).Set(third))
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	y := new(big.Float).SetPrec(200).Add(third, new(big.Float).SetMantExp(big.NewFloat(1), -150))
+	assert.ThatBigFloat(m, y).ApproxEqual(third)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: y: expected number to be approximately equal to 0.3333333333333333333333333333333333333333333333333333333333334, but it is 0.333333333333333333333333333333333333333333334033982565495742
+ abs err: 7.006492322e-46
+ rel err: 2.101947696e-45`)
+
+	m.Reset()
+	assert.ThatBigFloat(m, big.NewFloat(1.5)).Equal(big.NewFloat(1.25)).IsInf(0).InDelta(big.NewFloat(math.Inf(1)), big.NewFloat(1))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 1.25, but it is 1.5` +
+		`error# Assertion failed: expected number to be +Inf, but it is 1.5` +
+		`error# Assertion failed: expected number to be within ±1 of +Inf, but it is 1.5`)
+
+	m.Reset()
+	inf := big.NewFloat(math.Inf(1))
+	assert.ThatBigFloat(m, inf).IsInf(-1).IsFinite().InEpsilon(big.NewFloat(1), 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: inf: expected number to be -Inf, but it is +Inf` +
+		`error# Assertion failed: inf: expected number to be finite, but it is +Inf` + `error# Assertion failed: inf: expected number to be within relative error 1 of 1, but it is +Inf
+ abs err: +Inf
+ rel err: NaN`)
+}
+
+func TestBigRat(t *testing.T) {
+	m := new(internal.MockTestingT)
+	x := big.NewRat(1, 3)
+
+	m.Reset()
+	assert.ThatBigRat(m, x).
+		Equal(big.NewRat(2, 6)).
+		LessThan(big.NewRat(1, 2)).
+		InDelta(big.NewRat(1, 4), big.NewRat(1, 12)).
+		InEpsilon(big.NewRat(1, 4), 0.25).
+		Positive()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatBigRat(m, x).Equal(big.NewRat(1, 4)).InDelta(big.NewRat(1, 4), big.NewRat(1, 13))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: x: expected number to be equal to 0.25, but it is 1/3 (≈0.33333333333333333333)` +
+		`error# Assertion failed: x: expected number to be within ±1/13 (≈0.07692307692307692308) of 0.25, but it is 1/3 (≈0.33333333333333333333)`)
+
+	m.Reset()
+	assert.ThatBigRat(m, big.NewRat(-5, 1)).ApproxEqual(big.NewRat(-9, 2)).InEpsilon(big.NewRat(-9, 2), 0.1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be approximately equal to -4.5, but it is -5
+ abs err: 0.5
+ rel err: 0.1`)
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// Complex is the constraint of the complex number types.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// ComplexAssertion encapsulates a complex number and a test handler for making
+// assertions on the number.
+type ComplexAssertion[T Complex] struct {
+	AssertionBase[*ComplexAssertion[T]]
+	v T
+}
+
+// ThatComplex returns a ComplexAssertion for the given testing object and complex value.
+func ThatComplex[T Complex](t internal.TestingT, v T) *ComplexAssertion[T] {
+	a := &ComplexAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// Equal asserts that the complex value is equal to the expected value.
+// Values with a NaN part are never equal.
+func (a *ComplexAssertion[T]) Equal(expect T, msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	if a.v != expect {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.equal", expect, a.v),
+		}, msg...)
+	}
+	return a
+}
+
+// NotEqual asserts that the complex value is not equal to the expected value.
+func (a *ComplexAssertion[T]) NotEqual(expect T, msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	if a.v == expect {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.not_equal", expect),
+		}, msg...)
+	}
+	return a
+}
+
+// InDelta asserts that the modulus of the difference between the complex value
+// and the expected value is at most delta. Values with a NaN part are never within
+// the range, and infinite values only match themselves.
+func (a *ComplexAssertion[T]) InDelta(expect T, delta float64, msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	if a.v == expect {
+		return a
+	}
+	d := cmplx.Abs(complex128(a.v) - complex128(expect))
+	if !(d <= delta) || cmplx.IsInf(complex128(a.v)) || cmplx.IsInf(complex128(expect)) {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.in_delta", delta, expect, a.v),
+			Details: []internal.Detail{{Name: "abs_error", Value: strconv.FormatFloat(d, 'g', -1, 64)}},
+		}, msg...)
+	}
+	return a
+}
+
+// Phase asserts that the phase of the complex value, in radians, is within
+// the angular tolerance of the expected phase. Angles are compared on the
+// circle, so that π and -π are the same phase.
+func (a *ComplexAssertion[T]) Phase(expect float64, tolerance float64, msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	phase := cmplx.Phase(complex128(a.v))
+	d := math.Abs(math.Remainder(phase-expect, 2*math.Pi))
+	if !(d <= tolerance) {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.phase", tolerance, expect, phase),
+			Details: []internal.Detail{{Name: "abs_error", Value: strconv.FormatFloat(d, 'g', -1, 64)}},
+		}, msg...)
+	}
+	return a
+}
+
+// IsNaN asserts that the complex value is NaN, i.e. one of its parts is NaN
+// and none is infinite, according to cmplx.IsNaN.
+func (a *ComplexAssertion[T]) IsNaN(msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	if !cmplx.IsNaN(complex128(a.v)) {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.nan", a.v),
+		}, msg...)
+	}
+	return a
+}
+
+// IsInf asserts that the complex value is infinite, i.e. one of its parts is
+// infinite, according to cmplx.IsInf.
+func (a *ComplexAssertion[T]) IsInf(msg ...any) *ComplexAssertion[T] {
+	a.t.Helper()
+	if !cmplx.IsInf(complex128(a.v)) {
+		a.Fail(internal.Failure{
+			Summary: a.text("complex.inf", a.v),
+		}, msg...)
+	}
+	return a
+}
+
+// RealPart returns a NumberAssertion for the real part of the complex value,
+// labelled like "z.real" and sharing the failure mode of the assertion.
+func (a *ComplexAssertion[T]) RealPart() *NumberAssertion[float64] {
+	n := &NumberAssertion[float64]{v: real(complex128(a.v))}
+	n.AssertionBase = nested(&a.AssertionBase, n, "real")
+	return n
+}
+
+// ImagPart returns a NumberAssertion for the imaginary part of the complex
+// value, labelled like "z.imag" and sharing the failure mode of the assertion.
+func (a *ComplexAssertion[T]) ImagPart() *NumberAssertion[float64] {
+	n := &NumberAssertion[float64]{v: imag(complex128(a.v))}
+	n.AssertionBase = nested(&a.AssertionBase, n, "imag")
+	return n
+}
+
+// Modulus returns a NumberAssertion for the modulus of the complex value,
+// labelled like "z.modulus" and sharing the failure mode of the assertion.
+func (a *ComplexAssertion[T]) Modulus() *NumberAssertion[float64] {
+	n := &NumberAssertion[float64]{v: cmplx.Abs(complex128(a.v))}
+	n.AssertionBase = nested(&a.AssertionBase, n, "modulus")
+	return n
+}
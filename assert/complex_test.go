@@ -0,0 +1,132 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestComplex_Equal(t *testing.T) {
+	m := new(internal.MockTestingT)
+	z := complex(1, 2)
+
+	m.Reset()
+	assert.ThatComplex(m, z).Equal(1 + 2i).NotEqual(1 - 2i)
+	assert.ThatComplex(m, complex64(z)).Equal(1 + 2i)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatComplex(m, z).Equal(1 - 2i).NotEqual(1 + 2i)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: z: expected complex number to be equal to (1-2i), but it is (1+2i)` +
+		`error# Assertion failed: z: expected complex number not to be equal to (1+2i), but it is`)
+
+	m.Reset()
+	nan := cmplx.NaN()
+	assert.ThatComplex(m, nan).Require().Equal(nan, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: nan: expected complex number to be equal to (NaN+NaNi), but it is (NaN+NaNi)
+ message: index is 0`)
+}
+
+func TestComplex_InDelta(t *testing.T) {
+	m := new(internal.MockTestingT)
+	z := complex(3, 4)
+
+	m.Reset()
+	assert.ThatComplex(m, z).InDelta(0, 5).InDelta(3.1+4i, 0.1+1e-15)
+	assert.ThatComplex(m, cmplx.Inf()).InDelta(cmplx.Inf(), 0)
+	assert.ThatComplex(m, complex64(complex(math.MaxFloat32, 0))).InDelta(complex(-math.MaxFloat32, 0), math.Inf(1))
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatComplex(m, z).InDelta(0, 4.9)
+	assert.ThatComplex(m, z).InDelta(cmplx.NaN(), math.Inf(1))
+	assert.ThatComplex(m, cmplx.Inf()).InDelta(complex(math.Inf(1), 0), math.Inf(1))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: z: expected complex number to be within ±4.9 of (0+0i), but it is (3+4i)
+ abs err: 5` + `error# Assertion failed: z: expected complex number to be within ±+Inf of (NaN+NaNi), but it is (3+4i)
+ abs err: NaN` + `error# Assertion failed: cmplx.Inf(): expected complex number to be within ±+Inf of (+Inf+0i), but it is (+Inf+Infi)
+ abs err: +Inf`)
+}
+
+func TestComplex_Phase(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatComplex(m, 1i).Phase(math.Pi/2, 1e-12)
+	assert.ThatComplex(m, complex(-1, 1e-9)).Phase(-math.Pi, 1e-6)
+	assert.ThatComplex(m, complex(-1, -1e-9)).Phase(math.Pi, 1e-6)
+	assert.ThatComplex(m, 0i).Phase(0, 0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	z := complex(1, 1)
+	assert.ThatComplex(m, z).Phase(0, 0.5)
+	assert.ThatComplex(m, z).Phase(3*math.Pi/4, math.Pi/2)
+	assert.ThatComplex(m, cmplx.NaN()).Phase(0, math.Pi)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: z: expected phase to be within ±0.5 of 0, but it is 0.7853981633974483
+ abs err: 0.7853981633974483` + `error# Assertion failed: cmplx.NaN(): expected phase to be within ±3.141592653589793 of 0, but it is NaN
+ abs err: NaN`)
+}
+
+func TestComplex_NaN_Inf(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatComplex(m, complex(math.NaN(), 1)).IsNaN()
+	assert.ThatComplex(m, complex(math.Inf(-1), math.NaN())).IsInf()
+	assert.ThatComplex(m, complex64(complex(0, math.Inf(1)))).IsInf()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	z := complex(math.Inf(1), math.NaN())
+	assert.ThatComplex(m, z).IsNaN()
+	assert.ThatComplex(m, 1+2i).IsInf()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: z: expected complex number to be NaN, but it is (+Inf+NaNi)` +
+		`error# Assertion failed: expected complex number to be infinite, but it is (1+2i)`)
+}
+
+func TestComplex_Parts(t *testing.T) {
+	m := new(internal.MockTestingT)
+	z := complex64(complex(1.5, -2))
+
+	m.Reset()
+	assert.ThatComplex(m, z).RealPart().Equal(1.5).Positive()
+	assert.ThatComplex(m, z).ImagPart().Equal(-2)
+	assert.ThatComplex(m, complex(3, 4)).Modulus().Equal(5)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatComplex(m, z).RealPart().Equal(2)
+	assert.ThatComplex(m, z).As("signal").WithContext("sample", 3).ImagPart().Positive()
+	assert.ThatComplex(m, z).Require().Modulus().LessThan(1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: z.real: expected number to be equal to 2, but it is 1.5` +
+		`error# Assertion failed: signal.imag [sample=3]: expected number to be positive, but it is -2` +
+		`fatal# Assertion failed: z.modulus: expected number to be less than 1, but it is 2.5`)
+
+	// Test failures of the parts are failures of the complex number
+	m.Reset()
+	a := assert.ThatComplex(m, z).Check()
+	a.RealPart().Zero()
+	a.ImagPart().Negative()
+	assert.That(t, a.Passed()).False()
+	assert.ThatSlice(t, a.Failures()).Equal([]string{"z.real: expected number to be zero, but it is 1.5"})
+	assert.ThatString(t, m.String()).Equal("")
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// Config controls the behaviour of assertions: the style and language of
+// failure messages, the context of diffs, the limits of printed values and
+// the tolerance of float equality. Its defaults are read from the environment
+// variables GS_ASSERT_COLOR, GS_ASSERT_LAYOUT, COLUMNS, GS_ASSERT_LANG,
+// GS_ASSERT_DIFF_CONTEXT, GS_ASSERT_MAX_DEPTH, GS_ASSERT_MAX_ELEMENTS,
+// GS_ASSERT_MAX_STRING and GS_ASSERT_FLOAT_TOLERANCE.
+type Config = internal.Config
+
+// Configure changes the configuration shared by all tests, e.g.
+//
+//	assert.Configure(func(c *assert.Config) { c.Language = assert.Chinese })
+//
+// Passing nil restores the configuration selected by the environment variables.
+func Configure(fn func(c *Config)) {
+	internal.Configure(fn)
+}
+
+// WithConfig changes the configuration of the assertions made with t only,
+// on top of the global configuration, until the test finishes, e.g.
+//
+//	assert.WithConfig(t, func(c *assert.Config) { c.MaxElements = 10 })
+//
+// It's safe for parallel tests, each of which may have its own configuration.
+// Subtests don't inherit the configuration of their parent test.
+func WithConfig(t TestingT, fn func(c *Config)) {
+	internal.WithConfig(t, fn)
+}
+
+// GetConfig returns the configuration of the assertions made with t,
+// or the global configuration if t is nil.
+func GetConfig(t TestingT) Config {
+	if t == nil {
+		return internal.GlobalConfig()
+	}
+	return internal.ConfigFor(t)
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestNewConfig(t *testing.T) {
+	env := func(m map[string]string) func(string) string {
+		return func(key string) string { return m[key] }
+	}
+	assert.That(t, internal.NewConfig(env(nil), false)).Equal(assert.Config{
+		Language:    assert.English,
+		DiffContext: 3,
+		MaxDepth:    10,
+		MaxElements: 100,
+		MaxString:   1000,
+	})
+	assert.That(t, internal.NewConfig(env(map[string]string{
+		"GS_ASSERT_COLOR":           "always",
+		"GS_ASSERT_LANG":            "zh_CN.UTF-8",
+		"GS_ASSERT_DIFF_CONTEXT":    "-1",
+		"GS_ASSERT_MAX_DEPTH":       "3",
+		"GS_ASSERT_MAX_ELEMENTS":    "5",
+		"GS_ASSERT_MAX_STRING":      "x",
+		"GS_ASSERT_FLOAT_TOLERANCE": "1e-9",
+	}), false)).Equal(assert.Config{
+		Style:          assert.Style{Color: true},
+		Language:       assert.Chinese,
+		DiffContext:    -1,
+		MaxDepth:       3,
+		MaxElements:    5,
+		MaxString:      1000,
+		FloatTolerance: 1e-9,
+	})
+}
+
+func TestConfigure(t *testing.T) {
+	defer assert.Configure(nil)
+	def := assert.GetConfig(nil)
+
+	assert.Configure(func(c *assert.Config) { c.MaxElements = 2 })
+	assert.Configure(func(c *assert.Config) { c.MaxString = 3 })
+	assert.ThatNumber(t, assert.GetConfig(nil).MaxElements).Equal(2)
+	assert.ThatNumber(t, assert.GetConfig(t).MaxString).Equal(3)
+	assert.ThatString(t, assert.ToPrettyString([]string{"abcd", "b", "c"})).Equal(`{"abc"... 1 more, "b", ... 1 more}`)
+
+	assert.Configure(nil)
+	assert.That(t, assert.GetConfig(nil)).Equal(def)
+}
+
+func TestWithConfig(t *testing.T) {
+	var sub *testing.T
+	t.Run("sub", func(t *testing.T) {
+		sub = t
+		assert.WithConfig(t, func(c *assert.Config) { c.MaxDepth = 1 })
+		assert.WithConfig(t, func(c *assert.Config) { c.MaxElements = 1 })
+		assert.ThatNumber(t, assert.GetConfig(t).MaxDepth).Equal(1)
+		assert.ThatNumber(t, assert.GetConfig(t).MaxElements).Equal(1)
+		assert.ThatNumber(t, assert.GetConfig(nil).MaxDepth).Equal(10)
+	})
+	// Test the configuration is removed when the test finishes
+	assert.That(t, assert.GetConfig(sub)).Equal(assert.GetConfig(nil))
+
+	// Test parallel tests with different configurations
+	for i, lang := range []string{assert.English, assert.Chinese, assert.English, assert.Chinese} {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			t.Parallel()
+			m := new(internal.MockTestingT)
+			assert.WithConfig(m, func(c *assert.Config) {
+				c.Language = lang
+				c.MaxElements = i + 1
+			})
+			actual := "{" + strings.Repeat("nil, ", i+1) + fmt.Sprintf("... %d more}", 4-i)
+			chans := make([]chan int, 5)
+			want := map[string]string{
+				assert.English: "error# Assertion failed: chans: expected slice to be empty, but it is not\n  actual: " + actual,
+				assert.Chinese: "error# 断言失败: chans: 期望切片为空，但实际不为空\n  实际值: " + actual,
+			}[lang]
+			for range 100 {
+				m.Reset()
+				assert.ThatSlice(m, chans).Empty()
+				assert.ThatString(t, m.String()).Equal(want)
+			}
+		})
+	}
+}
+
+func TestConfig_Diff(t *testing.T) {
+	type Person struct {
+		Name    string
+		Age     int
+		Email   string
+		Address string
+	}
+	p1 := Person{Name: "Alice", Age: 30, Email: "alice@example.com", Address: "1 Main Street, Springfield"}
+	p2 := Person{Name: "Alice", Age: 31, Email: "alice@example.com", Address: "1 Main Street, Springfield"}
+
+	m := new(internal.MockTestingT)
+	assert.WithConfig(m, func(c *assert.Config) { c.DiffContext = 1 })
+	assert.That(m, p1).Equal(p2)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: p1: expected values to be equal, but they are different
+  actual: (assert_test.Person) {
+            Name:"Alice",
+            Age:30,
+            Email:"alice@example.com",
+            Address:"1 Main Street, Springfield",
+          }
+expected: (assert_test.Person) {
+            Name:"Alice",
+            Age:31,
+            Email:"alice@example.com",
+            Address:"1 Main Street, Springfield",
+          }
+    diff: @@ -2,3 +2,3 @@
+             Name:"Alice",
+          -  Age:30,
+          +  Age:31,
+             Email:"alice@example.com",`)
+
+	// Test multi-line strings are diffed line by line, in separate hunks
+	m.Reset()
+	assert.ThatString(m, "a\nb\nc\nd\ne\nf").Equal("a\nB\nc\nd\ne\nF\ng")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be equal, but they are not
+  actual: "a\nb\nc\nd\ne\nf"
+expected: "a\nB\nc\nd\ne\nF\ng"
+    diff: @@ -1,3 +1,3 @@
+           a
+          -b
+          +B
+           c
+          @@ -5,2 +5,3 @@
+           e
+          -f
+          +F
+          +g`)
+
+	// Test diffs can be disabled
+	m.Reset()
+	assert.WithConfig(m, func(c *assert.Config) { c.DiffContext = -1 })
+	assert.That(m, p1).Equal(p2)
+	assert.That(t, strings.Contains(m.String(), "diff:")).False()
+
+	// Test the common head and tail of large texts are not diffed
+	lines := make([]string, 10000)
+	for i := range lines {
+		lines[i] = strconv.Itoa(i)
+	}
+	actual := strings.Join(lines, "\n")
+	lines[5000] = "x"
+	m = new(internal.MockTestingT)
+	assert.WithConfig(m, func(c *assert.Config) { c.DiffContext = 0 })
+	assert.ThatString(m, actual).Equal(strings.Join(lines, "\n"))
+	assert.ThatString(t, m.String()).HasSuffix(`
+    diff: @@ -5001,1 +5001,1 @@
+          -5000
+          +x`)
+
+	// Test large changes are diffed as a removal and an addition
+	m.Reset()
+	assert.ThatString(m, actual).Equal(strings.Repeat("y\n", 999) + "y")
+	assert.ThatString(t, m.String()).Contains(`
+    diff: @@ -1,10000 +1,1000 @@
+          -0
+          -1
+`)
+	assert.ThatString(t, m.String()).Contains(`
+          -9999
+          +y
+`)
+}
+
+func TestConfig_FloatTolerance(t *testing.T) {
+	x, y := 0.1, 0.2
+	m := new(internal.MockTestingT)
+	assert.ThatNumber(m, x+y).Equal(0.3)
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: x+y: expected number to be equal to 0.3, but it is 0.30000000000000004")
+
+	m.Reset()
+	assert.WithConfig(m, func(c *assert.Config) { c.FloatTolerance = 1e-9 })
+	assert.ThatNumber(m, x+y).Equal(0.3)
+	assert.ThatNumber(m, 1.0).Equal(1.001)
+	assert.ThatNumber(m, 1e12).NotEqual(1e12 + 1)
+	assert.ThatNumber(m, 1).NotEqual(2)
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: expected number to be equal to 1.001, but it is 1" +
+		"error# Assertion failed: expected number not to be equal to 1.000000000001e+12, but it is")
+}
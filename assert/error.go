@@ -28,59 +28,61 @@ import (
 // It is used to perform validations on error values in test cases.
 type ErrorAssertion struct {
 	AssertionBase[*ErrorAssertion]
-	t internal.TestingT
 	v error
 }
 
 // ThatError returns a new ErrorAssertion for the given error value.
 func ThatError(t internal.TestingT, v error) *ErrorAssertion {
-	return &ErrorAssertion{
-		t: t,
-		v: v,
-	}
+	a := &ErrorAssertion{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
 }
 
 // Nil reports a test failure if the error is not nil.
-func (a *ErrorAssertion) Nil(msg ...string) *ErrorAssertion {
+func (a *ErrorAssertion) Nil(msg ...any) *ErrorAssertion {
 	a.t.Helper()
 	if a.v != nil {
-		str := fmt.Sprintf(`expected error to be nil, but it is not
-  actual: (%T) %q`, a.v, a.v.Error())
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("error.nil"),
+			Actual:  fmt.Sprintf("(%T) %q", a.v, a.v.Error()),
+		}, msg...)
 	}
 	return a
 }
 
 // NotNil reports a test failure if the error is nil.
-func (a *ErrorAssertion) NotNil(msg ...string) *ErrorAssertion {
+func (a *ErrorAssertion) NotNil(msg ...any) *ErrorAssertion {
 	a.t.Helper()
 	if a.v == nil {
-		str := `expected error to be non-nil, but it is nil`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("error.not_nil"),
+		}, msg...)
 	}
 	return a
 }
 
 // Is reports a test failure if the error is not the same as the given error.
-func (a *ErrorAssertion) Is(target error, msg ...string) *ErrorAssertion {
+func (a *ErrorAssertion) Is(target error, msg ...any) *ErrorAssertion {
 	a.t.Helper()
 	if !errors.Is(a.v, target) {
-		str := fmt.Sprintf(`expected error to be target (according to errors.Is), but they are different
-  actual: %v
-expected: %v`, a.v, target)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("error.is"),
+			Actual:   fmt.Sprintf("%v", a.v),
+			Expected: fmt.Sprintf("%v", target),
+		}, msg...)
 	}
 	return a
 }
 
 // NotIs reports a test failure if the error is the same as the given error.
-func (a *ErrorAssertion) NotIs(target error, msg ...string) *ErrorAssertion {
+func (a *ErrorAssertion) NotIs(target error, msg ...any) *ErrorAssertion {
 	a.t.Helper()
 	if errors.Is(a.v, target) {
-		str := fmt.Sprintf(`expected error not to be target (according to errors.Is), but they are equal 
-  actual: %v
-expected: %v`, a.v, target)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("error.not_is"),
+			Actual:   fmt.Sprintf("%v", a.v),
+			Expected: fmt.Sprintf("%v", target),
+		}, msg...)
 	}
 	return a
 }
@@ -88,19 +90,21 @@ expected: %v`, a.v, target)
 // Matches reports a test failure if the error string does not match the given expression.
 // It expects a non-nil error and uses the provided expression (typically a regex)
 // to validate the error message content. Optional custom failure messages can be provided.
-func (a *ErrorAssertion) Matches(expr string, msg ...string) *ErrorAssertion {
+func (a *ErrorAssertion) Matches(expr string, msg ...any) *ErrorAssertion {
 	a.t.Helper()
 	if a.v == nil {
-		str := `expected non-nil error, but got nil`
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("error.matches.nil"),
+		}, msg...)
 		return a
 	}
 	s := a.v.Error()
 	if ok, err := regexp.MatchString(expr, s); err != nil {
-		internal.Fail(a.t, a.fatalOnFailure, "invalid pattern", msg...)
+		a.Fail(internal.Failure{Summary: a.text("pattern.invalid")}, msg...)
 	} else if !ok {
-		str := fmt.Sprintf("got %q which does not match %q", s, expr)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("pattern.no_match", s, expr),
+		}, msg...)
 	}
 	return a
 }
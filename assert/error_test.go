@@ -52,14 +52,14 @@ func TestError_Nil(t *testing.T) {
 	assert.ThatError(m, errors.New("this is an error")).Require().Nil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected error to be nil, but it is not
   actual: (*errors.errorString) "this is an error"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with custom message
 	m.Reset()
 	assert.ThatError(m, errors.New("test error")).Nil("expected no error in this operation")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error to be nil, but it is not
   actual: (*errors.errorString) "test error"
- message: "expected no error in this operation"`)
+ message: expected no error in this operation`)
 }
 
 func TestError_NotNil(t *testing.T) {
@@ -79,13 +79,13 @@ func TestError_NotNil(t *testing.T) {
 	m.Reset()
 	assert.ThatError(m, nil).Require().NotNil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected error to be non-nil, but it is nil
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with custom message
 	m.Reset()
 	assert.ThatError(m, nil).NotNil("expected an error in this operation")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error to be non-nil, but it is nil
- message: "expected an error in this operation"`)
+ message: expected an error in this operation`)
 }
 
 func TestError_Is(t *testing.T) {
@@ -100,17 +100,17 @@ func TestError_Is(t *testing.T) {
 	// Test failed case - different errors
 	m.Reset()
 	assert.ThatError(m, err).Is(errors.New("another error"))
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error to be target (according to errors.Is), but they are different
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: err: expected error to be target (according to errors.Is), but they are different
   actual: this is an error
 expected: another error`)
 
 	// Test failed case with Require - should fatal
 	m.Reset()
 	assert.ThatError(m, err).Require().Is(errors.New("another error"), "index is 0")
-	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected error to be target (according to errors.Is), but they are different
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: err: expected error to be target (according to errors.Is), but they are different
   actual: this is an error
 expected: another error
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with wrapped error - should not match the root error (because we're checking Is in wrong direction)
 	m.Reset()
@@ -138,7 +138,7 @@ expected: this is an error`)
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error to be target (according to errors.Is), but they are different
   actual: some error
 expected: other error
- message: "expected errors to match"`)
+ message: expected errors to match`)
 }
 
 func TestError_NotIs(t *testing.T) {
@@ -153,17 +153,17 @@ func TestError_NotIs(t *testing.T) {
 	// Test failed case - same errors
 	m.Reset()
 	assert.ThatError(m, err).NotIs(err)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error not to be target (according to errors.Is), but they are equal 
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: err: expected error not to be target (according to errors.Is), but they are equal
   actual: this is an error
 expected: this is an error`)
 
 	// Test failed case with Require - should fatal
 	m.Reset()
 	assert.ThatError(m, err).Require().NotIs(err, "index is 0")
-	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected error not to be target (according to errors.Is), but they are equal 
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: err: expected error not to be target (according to errors.Is), but they are equal
   actual: this is an error
 expected: this is an error
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with wrapped error - wrapped error contains root error, so NotIs should fail
 	m.Reset()
@@ -186,10 +186,10 @@ expected: this is an error
 	// Test with custom message on failure
 	m.Reset()
 	assert.ThatError(m, err).NotIs(err, "expected errors to be different")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected error not to be target (according to errors.Is), but they are equal 
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: err: expected error not to be target (according to errors.Is), but they are equal
   actual: this is an error
 expected: this is an error
- message: "expected errors to be different"`)
+ message: expected errors to be different`)
 }
 
 func TestError_Matches(t *testing.T) {
@@ -214,7 +214,7 @@ func TestError_Matches(t *testing.T) {
 	m.Reset()
 	assert.ThatError(m, nil).Matches("an error", "index is 0")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected non-nil error, but got nil
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test failed match with Require - should fatal
 	m.Reset()
@@ -225,7 +225,7 @@ func TestError_Matches(t *testing.T) {
 	m.Reset()
 	assert.ThatError(m, errors.New("there's no error")).Require().Matches("an error", "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: got "there's no error" which does not match "an error"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with regex pattern that matches
 	m.Reset()
@@ -251,5 +251,5 @@ func TestError_Matches(t *testing.T) {
 	m.Reset()
 	assert.ThatError(m, errors.New("some error")).Matches("nonexistent", "expected error to match pattern")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: got "some error" which does not match "nonexistent"
- message: "expected error to match pattern"`)
+ message: expected error to match pattern`)
 }
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// Extract returns a SliceAssertion for the values projected from the elements
+// of the slice by fn, e.g. the IDs of users, labelled like "users[*]" and
+// sharing the failure mode of the assertion.
+func Extract[T, U comparable](a *SliceAssertion[T], fn func(T) U) *SliceAssertion[U] {
+	v := make([]U, len(a.v))
+	for i, e := range a.v {
+		v[i] = fn(e)
+	}
+	s := &SliceAssertion[U]{v: v}
+	s.AssertionBase = nested(&a.AssertionBase, s, "[*]")
+	return s
+}
+
+// Extracting returns a SliceAssertion for the values of the field at the
+// dotted path, like "ID" or "Address.City", of the elements of the slice,
+// labelled like "users[*].Address.City". Pointers and interfaces along the
+// path are dereferenced. If the path can't be followed for an element, e.g.
+// because a pointer is nil, or the value isn't comparable, e.g. a slice, it
+// fails, and the returned assertion only records its failures.
+func (a *SliceAssertion[T]) Extracting(path string) *SliceAssertion[any] {
+	a.t.Helper()
+	v := make([]any, len(a.v))
+	var summary string
+	for i, e := range a.v {
+		f, s := a.extractField(reflect.ValueOf(e), path, i)
+		if s != "" {
+			summary = s
+			break
+		}
+		v[i] = f
+	}
+	if summary != "" {
+		a.Fail(internal.Failure{Summary: summary, Actual: a.json(a.v)})
+	}
+	s := &SliceAssertion[any]{v: v}
+	s.AssertionBase = nested(&a.AssertionBase, s, "[*]."+path)
+	s.checkOnly = s.checkOnly || summary != ""
+	return s
+}
+
+// extractField returns the value of the field at the dotted path of the
+// element at the index, or the failure summary if the path can't be followed.
+func (a *SliceAssertion[T]) extractField(v reflect.Value, path string, index int) (any, string) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		for (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+			v = v.Elem()
+		}
+		if !v.IsValid() || v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if i == 0 {
+				return nil, a.text("slice.extracting.nil_element", path, index)
+			}
+			return nil, a.text("slice.extracting.nil", path, index, strings.Join(names[:i], "."))
+		}
+		var f reflect.StructField
+		ok := v.Kind() == reflect.Struct
+		if ok {
+			f, ok = v.Type().FieldByName(name)
+		}
+		if !ok || !f.IsExported() {
+			return nil, a.text("slice.extracting.no_field", path, index, v.Type().String(), name)
+		}
+		fv, err := v.FieldByIndexErr(f.Index)
+		if err != nil {
+			embedded := append(names[:i:i], nilEmbedded(v, f.Index)...)
+			return nil, a.text("slice.extracting.nil", path, index, strings.Join(embedded, "."))
+		}
+		v = fv
+	}
+	if !v.Comparable() {
+		return nil, a.text("slice.extracting.not_comparable", path, index, v.Type().String())
+	}
+	return v.Interface(), ""
+}
+
+// nilEmbedded returns the names of the embedded fields of the struct v along
+// the index of a promoted field, up to the first nil embedded pointer.
+func nilEmbedded(v reflect.Value, index []int) []string {
+	var names []string
+	for _, i := range index[:len(index)-1] {
+		names = append(names, v.Type().Field(i).Name)
+		v = v.Field(i)
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+	}
+	return names
+}
+
+// Filter returns a SliceAssertion for the elements of the slice that satisfy
+// pred, in the same order, labelled like "users[?]" and sharing the failure
+// mode of the assertion.
+func (a *SliceAssertion[T]) Filter(pred func(T) bool) *SliceAssertion[T] {
+	var v []T
+	for _, e := range a.v {
+		if pred(e) {
+			v = append(v, e)
+		}
+	}
+	s := &SliceAssertion[T]{v: v}
+	s.AssertionBase = nested(&a.AssertionBase, s, "[?]")
+	return s
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+type address struct {
+	City string
+}
+
+type user struct {
+	ID      int
+	Name    string
+	Address *address
+	secret  string
+}
+
+func TestExtract(t *testing.T) {
+	m := new(internal.MockTestingT)
+	users := []user{{ID: 1, Name: "bob"}, {ID: 2, Name: "alice"}}
+
+	m.Reset()
+	assert.Extract(assert.ThatSlice(m, users), func(u user) int { return u.ID }).Equal([]int{1, 2})
+	assert.Extract(assert.ThatSlice(m, users), func(u user) string { return u.Name }).Contains("alice")
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.Extract(assert.ThatSlice(m, users), func(u user) string { return u.Name }).Contains("tom", "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: users[*]: expected slice to contain element "tom", but it is missing
+  actual: ["bob","alice"]
+ message: index is 0`)
+}
+
+func TestExtracting(t *testing.T) {
+	m := new(internal.MockTestingT)
+	users := []*user{
+		{ID: 1, Name: "bob", Address: &address{City: "Paris"}},
+		{ID: 2, Name: "alice", Address: &address{City: "Tokyo"}},
+	}
+
+	m.Reset()
+	assert.ThatSlice(m, users).Extracting("ID").Equal([]any{1, 2})
+	assert.ThatSlice(m, users).Extracting("Address.City").ContainsAll([]any{"Tokyo", "Paris"})
+	assert.ThatSlice(m, []user{}).Extracting("Name").Empty()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, users).Extracting("Address.City").Contains("London")
+	assert.ThatSlice(m, users).Require().Extracting("ID").AllMatches(func(id any) bool { return id.(int) > 1 })
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: users[*].Address.City: expected slice to contain element "London", but it is missing
+  actual: ["Paris","Tokyo"]` + `fatal# Assertion failed: users[*].ID: expected all elements in the slice to satisfy the condition, but element 1 does not
+  actual: [1,2]`)
+}
+
+func TestExtracting_Invalid(t *testing.T) {
+	m := new(internal.MockTestingT)
+	users := []*user{{ID: 1, Address: &address{}}, {ID: 2}, nil}
+
+	m.Reset()
+	assert.ThatSlice(m, users[:2]).Extracting("Address.City").Contains("Paris")
+	assert.ThatSlice(m, users[2:]).Extracting("ID")
+	assert.ThatSlice(m, users[:1]).Extracting("Address.Zip")
+	assert.ThatSlice(m, users[:1]).Extracting("secret")
+	assert.ThatSlice(m, users[:1]).Extracting("ID.Value")
+	assert.ThatSlice(m, []any{nil}).Extracting("ID")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: users[:2]: cannot extract Address.City from element 1, as Address is nil
+  actual: [{"ID":1,"Name":"","Address":{"City":""}},{"ID":2,"Name":"","Address":null}]` +
+		`error# Assertion failed: users[2:]: cannot extract ID from element 0, as it is nil
+  actual: [null]` +
+		`error# Assertion failed: users[:1]: cannot extract Address.Zip from element 0, as type assert_test.address has no exported field Zip
+  actual: [{"ID":1,"Name":"","Address":{"City":""}}]` +
+		`error# Assertion failed: users[:1]: cannot extract secret from element 0, as type assert_test.user has no exported field secret
+  actual: [{"ID":1,"Name":"","Address":{"City":""}}]` +
+		`error# Assertion failed: users[:1]: cannot extract ID.Value from element 0, as type int has no exported field Value
+  actual: [{"ID":1,"Name":"","Address":{"City":""}}]` +
+		`error# Assertion failed: cannot extract ID from element 0, as it is nil
+  actual: [null]`)
+}
+
+func TestExtracting_NotComparable(t *testing.T) {
+	m := new(internal.MockTestingT)
+	type team struct {
+		Members []string
+	}
+	teams := []*team{{Members: []string{"bob"}}, {Members: []string{"bob"}}}
+
+	m.Reset()
+	assert.ThatSlice(m, teams).Extracting("Members").Contains([]string{"bob"}).Equal([]any{nil, nil}).AllUnique()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: teams: cannot extract Members from element 0, as type []string is not comparable
+  actual: [{"Members":["bob"]},{"Members":["bob"]}]`)
+}
+
+func TestExtracting_NilEmbedded(t *testing.T) {
+	m := new(internal.MockTestingT)
+	type base struct {
+		ID int
+	}
+	type account struct {
+		*base
+		Name string
+	}
+	accounts := []account{{base: &base{ID: 1}, Name: "bob"}, {Name: "alice"}}
+
+	m.Reset()
+	assert.ThatSlice(m, accounts[:1]).Extracting("ID").Equal([]any{1})
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, accounts).Extracting("ID").Equal([]any{1, 2})
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: accounts: cannot extract ID from element 1, as base is nil
+  actual: [{"ID":1,"Name":"bob"},{"Name":"alice"}]`)
+}
+
+func TestFilter(t *testing.T) {
+	m := new(internal.MockTestingT)
+	scores := []int{90, 45, 72, 30}
+	failing := func(n int) bool { return n < 60 }
+
+	m.Reset()
+	assert.ThatSlice(m, scores).Filter(failing).Equal([]int{45, 30})
+	assert.ThatSlice(m, scores).Filter(func(n int) bool { return n > 100 }).Empty()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, scores).Filter(failing).Length(1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: scores[?]: expected slice to have length 1, but it has length 2
+  actual: [45,30]`)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// Failure describes a failed assertion: the name of the assertion, the
+// actual and expected values, the user message, the location of the
+// assertion and the label of the asserted value. It is delivered by a Reporter.
+type Failure = internal.Failure
+
+// Detail is a named value of a failure, such as the pattern of a match.
+type Detail = internal.Detail
+
+// Location is the position of a failed assertion in the user code.
+type Location = internal.Location
+
+// Style controls how a failure is rendered as text by Failure.Render.
+// The default TextReporter selects it by the environment variables:
+// GS_ASSERT_COLOR ("auto", "always" or "never", honoring NO_COLOR in auto mode)
+// and GS_ASSERT_LAYOUT ("stacked" or "side-by-side", within COLUMNS).
+type Style = internal.Style
+
+// Reporter formats a failure and delivers it to the test context.
+// Custom reporters may emit JSON, colored output, IDE integrations, etc.
+type Reporter = internal.Reporter
+
+// ReporterFunc is an adapter to allow the use of ordinary functions as reporters.
+type ReporterFunc = internal.ReporterFunc
+
+// TextReporter is the default reporter. It reports failures as plain text
+// through `t.Error`, or through `t.Fatal` for the `require` package.
+type TextReporter = internal.TextReporter
+
+// SetReporter sets the reporter of assertion failures. Passing nil restores
+// the reporter selected by GS_ASSERT_REPORTER, by default the TextReporter.
+func SetReporter(r Reporter) {
+	internal.SetReporter(r)
+}
+
+// MultiReporter delivers each failure to all the reporters in order.
+type MultiReporter = internal.MultiReporter
+
+// GitHubReporter emits GitHub Actions workflow commands, so that the
+// failures are annotated on the changed lines of a pull request.
+// It's enabled by setting the environment variable GS_ASSERT_REPORTER=github.
+type GitHubReporter = internal.GitHubReporter
+
+// JUnitReporter writes the failures per test into a JUnit XML report file.
+// It's enabled by setting the environment variable GS_ASSERT_REPORTER=junit,
+// and GS_ASSERT_REPORT_DIR selects the directory of the report files.
+type JUnitReporter = internal.JUnitReporter
+
+// TAPReporter writes the failures per test into a TAP version 13 report file.
+// It's enabled by setting the environment variable GS_ASSERT_REPORTER=tap,
+// and GS_ASSERT_REPORT_DIR selects the directory of the report files.
+type TAPReporter = internal.TAPReporter
+
+// NewJUnitReporter creates a JUnitReporter writing to the given file.
+func NewJUnitReporter(path string) *JUnitReporter {
+	return internal.NewJUnitReporter(path)
+}
+
+// NewTAPReporter creates a TAPReporter writing to the given file.
+func NewTAPReporter(path string) *TAPReporter {
+	return internal.NewTAPReporter(path)
+}
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/asserttest"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestFailure_String(t *testing.T) {
+	f := &assert.Failure{
+		Summary:  "expected strings to be equal, but they are not",
+		Actual:   `"abc"`,
+		Expected: `"abd"`,
+		Details:  []assert.Detail{{Name: "pattern", Value: `"a.c"`}},
+		Message:  "index is 0",
+		Label:    "user.name",
+		Context:  []any{"tenant", "acme"},
+	}
+	assert.ThatString(t, f.String()).Equal(`user.name [tenant=acme]: expected strings to be equal, but they are not
+  actual: "abc"
+expected: "abd"
+ pattern: "a.c"
+ message: index is 0`)
+}
+
+func TestFailure_Render(t *testing.T) {
+	f := &assert.Failure{
+		Summary:  "expected strings to be equal, but they are not",
+		Actual:   `"abcd"`,
+		Expected: `"abxd"`,
+		Message:  "index is 0",
+		Label:    "s",
+	}
+
+	// Test colored output
+	s := assert.Style{Color: true}
+	assert.ThatString(t, f.Render(s)).Equal("\x1b[1ms: \x1b[0mexpected strings to be equal, but they are not\n" +
+		"  actual: \x1b[31m\"ab\x1b[0m\x1b[1;4;31mc\x1b[0m\x1b[31md\"\x1b[0m\n" +
+		"expected: \x1b[32m\"ab\x1b[0m\x1b[1;4;32mx\x1b[0m\x1b[32md\"\x1b[0m\n" +
+		" message: index is 0")
+
+	// Test side-by-side layout
+	f.Actual = "{\n  Name: \"Alice\",\n  Age: 30,\n}"
+	f.Expected = "{\n  Name: \"Alice\",\n  Age: 31,\n}"
+	s = assert.Style{SideBySide: true, Width: 80}
+	assert.ThatString(t, f.Render(s)).Equal(`s: expected strings to be equal, but they are not
+  actual: {                | expected: {
+            Name: "Alice", |             Name: "Alice",
+            Age: 30,       |             Age: 31,
+          }                |           }
+ message: index is 0`)
+
+	// Test side-by-side layout falls back to stacked layout if it doesn't fit
+	s = assert.Style{SideBySide: true, Width: 40}
+	assert.ThatString(t, f.Render(s)).Equal(`s: expected strings to be equal, but they are not
+  actual: {
+            Name: "Alice",
+            Age: 30,
+          }
+expected: {
+            Name: "Alice",
+            Age: 31,
+          }
+ message: index is 0`)
+
+	// Test labels are aligned to the longest detail name
+	f.Details = []assert.Detail{{Name: "[0, 2, 4]", Value: "1"}}
+	s = assert.Style{SideBySide: true, Width: 80}
+	assert.ThatString(t, f.Render(s)).Equal(`s: expected strings to be equal, but they are not
+   actual: {                |  expected: {
+             Name: "Alice", |              Name: "Alice",
+             Age: 30,       |              Age: 31,
+           }                |            }
+[0, 2, 4]: 1
+  message: index is 0`)
+	f.Details = nil
+
+	// Test lines without counterpart are highlighted entirely
+	f.Actual = "1\n2"
+	f.Expected = "1"
+	s = assert.Style{Color: true, SideBySide: true}
+	assert.ThatString(t, f.Render(s)).Equal("\x1b[1ms: \x1b[0mexpected strings to be equal, but they are not\n" +
+		"  actual: \x1b[31m1\x1b[0m | expected: \x1b[32m1\x1b[0m\n" +
+		"          \x1b[1;4;31m2\x1b[0m |           \n" +
+		" message: index is 0")
+}
+
+func TestNewStyle(t *testing.T) {
+	env := func(m map[string]string) func(string) string {
+		return func(key string) string { return m[key] }
+	}
+	assert.That(t, internal.NewStyle(env(nil), false)).Equal(assert.Style{})
+	assert.That(t, internal.NewStyle(env(nil), true)).Equal(assert.Style{Color: true})
+	assert.That(t, internal.NewStyle(env(map[string]string{"NO_COLOR": "1"}), true)).Equal(assert.Style{})
+	assert.That(t, internal.NewStyle(env(map[string]string{"TERM": "dumb"}), true)).Equal(assert.Style{})
+	assert.That(t, internal.NewStyle(env(map[string]string{"GS_ASSERT_COLOR": "never"}), true)).Equal(assert.Style{})
+	assert.That(t, internal.NewStyle(env(map[string]string{"GS_ASSERT_COLOR": "always", "NO_COLOR": "1"}), false)).Equal(assert.Style{Color: true})
+	assert.That(t, internal.NewStyle(env(map[string]string{
+		"GS_ASSERT_LAYOUT": "side-by-side",
+		"COLUMNS":          "200",
+	}), false)).Equal(assert.Style{SideBySide: true, Width: 200})
+}
+
+func TestSetReporter(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	var failures []*assert.Failure
+	assert.SetReporter(assert.ReporterFunc(func(t assert.TestingT, f *assert.Failure) {
+		if t != m {
+			assert.TextReporter{}.Report(t, f)
+			return
+		}
+		failures = append(failures, f)
+	}))
+	defer assert.SetReporter(nil)
+
+	count := 5
+	l := line() + 1
+	assert.ThatNumber(m, count).Require().Equal(3, "index is %d", 0)
+	assert.Panic(m, func() {}, "boom")
+	assert.ThatString(t, m.String()).Equal("")
+
+	assert.ThatNumber(t, len(failures)).Equal(2)
+
+	f := failures[0]
+	assert.ThatString(t, f.Assertion).Equal("NumberAssertion.Equal")
+	assert.ThatString(t, f.Summary).Equal("expected number to be equal to 3, but it is 5")
+	assert.ThatString(t, f.Label).Equal("count")
+	assert.ThatString(t, f.Message).Equal("index is 0")
+	assert.ThatString(t, filepath.Base(f.Location.File)).Equal("failure_test.go")
+	assert.ThatNumber(t, f.Location.Line).Equal(l)
+	assert.ThatString(t, f.Location.Function).HasSuffix("assert_test.TestSetReporter")
+	assert.That(t, f.Fatal).True()
+
+	f = failures[1]
+	assert.ThatString(t, f.Assertion).Equal("Panic")
+	assert.ThatString(t, f.Summary).Equal("did not panic")
+	assert.That(t, f.Fatal).False()
+
+	// Test the default reporter is restored
+	assert.SetReporter(nil)
+	assert.ThatNumber(m, count).Equal(3)
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: count: expected number to be equal to 3, but it is 5")
+}
+
+func TestTextReporter(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	user := &User{ID: 42, Name: "Alice"}
+
+	// Test the composite values of the context are logged at the first failure
+	r := asserttest.Run("TestTextReporter", func(t *asserttest.Recorder) {
+		a := assert.ThatNumber(t, 1).WithContext("tenant", "acme", "user", user, "roles", []string{"admin"})
+		a.Equal(2).Equal(3)
+	})
+	assert.ThatSlice(t, r.Calls()).Equal([]asserttest.Call{
+		{Level: asserttest.LevelLog, Message: `context user: {ID:42, Name:"Alice"}`},
+		{Level: asserttest.LevelLog, Message: `context roles: {"admin"}`},
+		{Level: asserttest.LevelError, Message: "Assertion failed: [tenant=acme, user=&{42 Alice}, roles=[admin]]: expected number to be equal to 2, but it is 1"},
+		{Level: asserttest.LevelError, Message: "Assertion failed: [tenant=acme, user=&{42 Alice}, roles=[admin]]: expected number to be equal to 3, but it is 1"},
+	})
+
+	// Test the context is not logged if the test context doesn't support Logf
+	m := new(internal.MockTestingT)
+	assert.ThatNumber(m, 1).WithContext("user", user).Equal(2)
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: [user=&{42 Alice}]: expected number to be equal to 2, but it is 1")
+}
+
+func TestGitHubReporter(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	var buf bytes.Buffer
+	wd, _ := os.Getwd()
+	assert.SetReporter(assert.MultiReporter{
+		&assert.GitHubReporter{Out: &buf, Root: filepath.Dir(wd)},
+		assert.TextReporter{},
+	})
+	defer assert.SetReporter(nil)
+
+	s := "a,b"
+	l := line() + 1
+	assert.ThatString(m, s).Equal("a:b", "100%")
+	assert.ThatString(t, buf.String()).Equal("::error file=assert/failure_test.go,line=" + strconv.Itoa(l) + ",title=StringAssertion.Equal::" +
+		`s: expected strings to be equal, but they are not%0A  actual: "a,b"%0Aexpected: "a:b"%0A message: 100%25` + "\n")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: s: expected strings to be equal, but they are not
+  actual: "a,b"
+expected: "a:b"
+ message: 100%`)
+}
+
+// cleanupT is a MockTestingT that records its cleanup functions.
+type cleanupT struct {
+	internal.MockTestingT
+	cleanups []func()
+}
+
+func (c *cleanupT) Cleanup(fn func()) {
+	c.cleanups = append(c.cleanups, fn)
+}
+
+func TestJUnitReporter(t *testing.T) {
+	m := new(cleanupT)
+
+	file := filepath.Join(t.TempDir(), "report.xml")
+	assert.SetReporter(assert.NewJUnitReporter(file))
+	defer assert.SetReporter(nil)
+
+	n := 5
+	l := line() + 1
+	assert.ThatNumber(m, n).Equal(3)
+	assert.ThatNumber(m, n).Require().LessThan(3)
+	assert.SetReporter(nil)
+
+	// Test the report is written once, when the test finishes
+	_, err := os.Stat(file)
+	assert.That(t, os.IsNotExist(err)).True()
+	assert.ThatNumber(t, len(m.cleanups)).Equal(1)
+	m.cleanups[0]()
+
+	b, err := os.ReadFile(file)
+	assert.ThatError(t, err).Nil()
+	assert.ThatString(t, string(b)).Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="github.com/go-spring/gs-assert/assert_test" tests="1" failures="1">
+    <testcase name="TestJUnitReporter" classname="github.com/go-spring/gs-assert/assert_test">
+      <failure message="expected number to be equal to 3, but it is 5" type="NumberAssertion.Equal">` + wd() + `/failure_test.go:` + strconv.Itoa(l) + `&#xA;n: expected number to be equal to 3, but it is 5</failure>
+      <failure message="expected number to be less than 3, but it is 5" type="NumberAssertion.LessThan">` + wd() + `/failure_test.go:` + strconv.Itoa(l+1) + `&#xA;n: expected number to be less than 3, but it is 5</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`)
+}
+
+func TestTAPReporter(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	file := filepath.Join(t.TempDir(), "report.tap")
+	r := assert.NewTAPReporter(file)
+	assert.SetReporter(r)
+	defer assert.SetReporter(nil)
+
+	s := []int{1}
+	l := line() + 1
+	assert.ThatSlice(m, s).Contains(2, "index is 0")
+	assert.SetReporter(nil)
+
+	// Test the report is written by Flush without cleanup
+	assert.ThatError(t, r.Flush()).Nil()
+	b, err := os.ReadFile(file)
+	assert.ThatError(t, err).Nil()
+	assert.ThatString(t, string(b)).Equal(`TAP version 13
+1..1
+not ok 1 - TestTAPReporter
+  ---
+  failures:
+    - assertion: SliceAssertion.Contains
+      at: ` + wd() + `/failure_test.go:` + strconv.Itoa(l) + `
+      message: |
+        s: expected slice to contain element 2, but it is missing
+          actual: [1]
+         message: index is 0
+  ...
+`)
+}
+
+func TestNewReporter(t *testing.T) {
+	m := new(cleanupT)
+
+	assert.That(t, internal.NewReporter("", "")).Equal(assert.TextReporter{})
+	assert.That(t, internal.NewReporter("unknown", "")).Equal(assert.TextReporter{})
+
+	dir := t.TempDir()
+	r := internal.NewReporter("junit, TAP", dir)
+	assert.SetReporter(r)
+	defer assert.SetReporter(nil)
+
+	assert.That(m, true).False()
+	assert.SetReporter(nil)
+	assert.ThatString(t, m.String()).Equal("error# Assertion failed: expected value to be false, but it is true")
+	for _, fn := range m.cleanups {
+		fn()
+	}
+
+	entries, err := os.ReadDir(dir)
+	assert.ThatError(t, err).Nil()
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.ThatSlice(t, names).Equal([]string{
+		"github_com_go-spring_gs-assert_assert_test.tap",
+		"github_com_go-spring_gs-assert_assert_test.xml",
+	})
+}
+
+// line returns the line number of its caller.
+func line() int {
+	_, _, l, _ := runtime.Caller(1)
+	return l
+}
+
+// wd returns the current working directory.
+func wd() string {
+	dir, _ := os.Getwd()
+	return dir
+}
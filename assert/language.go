@@ -0,0 +1,57 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"maps"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// Languages of failure messages supported out of the box.
+const (
+	English = internal.English
+	Chinese = internal.Chinese
+)
+
+// SetLanguage sets the language of failure messages, e.g. assert.Chinese.
+// Language tags such as "zh-CN" are reduced to their primary subtag.
+// Passing "" restores the language selected by the environment variable
+// GS_ASSERT_LANG, English by default.
+func SetLanguage(lang string) {
+	internal.SetLanguage(lang)
+}
+
+// GetLanguage returns the language of failure messages.
+func GetLanguage() string {
+	return internal.GetLanguage()
+}
+
+// RegisterLanguage adds a message catalogue for a new language, or
+// overrides messages of an existing one. The keys are those of the English
+// catalogue returned by Catalog(English), and the values are format strings
+// taking the same arguments, which may be reordered with explicit argument
+// indexes such as "%[2]v". Keys missing in the catalogue fall back to English.
+func RegisterLanguage(lang string, messages map[string]string) {
+	internal.RegisterLanguage(lang, messages)
+}
+
+// Catalog returns a copy of the message catalogue of the language,
+// or nil if the language is not registered.
+func Catalog(lang string) map[string]string {
+	return maps.Clone(internal.Catalog(lang))
+}
@@ -0,0 +1,366 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"errors"
+	"math/big"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+	"github.com/go-spring/gs-assert/require"
+)
+
+// verbs returns the formatting verbs of a catalogue message, keyed by the
+// index of the argument they consume, so that translations can reorder them.
+func verbs(format string) map[int]string {
+	m := map[int]string{}
+	arg := 1
+	re := regexp.MustCompile(`%([+#\- 0]*)(\[(\d+)])?([a-zA-Z%])`)
+	for _, v := range re.FindAllStringSubmatch(format, -1) {
+		if v[4] == "%" {
+			continue
+		}
+		if v[3] != "" {
+			arg, _ = strconv.Atoi(v[3])
+		}
+		m[arg] = v[1] + v[4]
+		arg++
+	}
+	return m
+}
+
+func TestCatalog(t *testing.T) {
+	en := assert.Catalog(assert.English)
+	assert.ThatMap(t, en).NotEmpty()
+	assert.That(t, assert.Catalog("xx")).Nil()
+
+	for _, lang := range []string{assert.Chinese} {
+		c := assert.Catalog(lang)
+		assert.ThatMap(t, c).As(lang).HasSameKeys(en)
+		for k, v := range en {
+			assert.That(t, verbs(c[k])).As(lang + "." + k).Equal(verbs(v))
+		}
+	}
+}
+
+func TestSetLanguage(t *testing.T) {
+	defer assert.SetLanguage("")
+
+	assert.SetLanguage("zh_CN.UTF-8")
+	assert.ThatString(t, assert.GetLanguage()).Equal(assert.Chinese)
+
+	assert.SetLanguage("EN-us")
+	assert.ThatString(t, assert.GetLanguage()).Equal(assert.English)
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	defer assert.SetLanguage("")
+	assert.RegisterLanguage("fr", map[string]string{
+		"failure.prefix": "Échec de l'assertion : ",
+		"field.actual":   "obtenu",
+		"field.expected": "attendu",
+		"number.equal":   "le nombre devrait être %v, mais il vaut %v",
+	})
+	assert.SetLanguage("fr-FR")
+
+	m := new(internal.MockTestingT)
+	assert.ThatNumber(m, 1).Equal(2)
+	assert.ThatString(t, m.String()).Equal("error# Échec de l'assertion : le nombre devrait être 2, mais il vaut 1")
+
+	// Test messages missing in the catalogue fall back to English
+	m.Reset()
+	assert.ThatString(m, "abc").Equal("abd", "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Échec de l'assertion : expected strings to be equal, but they are not
+  obtenu: "abc"
+ attendu: "abd"
+ message: index is 0`)
+}
+
+// TestLanguages checks how the messages of each catalogue are rendered: the
+// prefix, the localized detail labels and the reordered arguments, on one
+// message of each assertion type. The exact messages of all the assertions
+// are tested in English by the tests of each assertion type, and TestCatalog
+// checks that every catalogue has the same keys and verbs as English.
+func TestLanguages(t *testing.T) {
+	errTarget := errors.New("target")
+
+	testcases := []struct {
+		name string
+		fn   func(m *internal.MockTestingT)
+		want map[string]string
+	}{
+		{
+			name: "Panic",
+			fn: func(m *internal.MockTestingT) {
+				assert.Panic(m, func() {}, "boom")
+			},
+			want: map[string]string{
+				assert.English: "error# Assertion failed: did not panic",
+				assert.Chinese: "error# 断言失败: 没有发生 panic",
+			},
+		},
+		{
+			name: "Panic match",
+			fn: func(m *internal.MockTestingT) {
+				assert.Panic(m, func() { panic("oops") }, "boom")
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: got "oops" which does not match "boom"`,
+				assert.Chinese: `error# 断言失败: 得到 "oops"，与模式 "boom" 不匹配`,
+			},
+		},
+		{
+			name: "Assertion.Equal",
+			fn: func(m *internal.MockTestingT) {
+				assert.That(m, 1).Equal("1", "index is %d", 0)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected values to be equal, but they are different
+  actual: (int) 1
+expected: (string) "1"
+ message: index is 0`,
+				assert.Chinese: `error# 断言失败: 期望两个值相等，但实际不同
+  实际值: (int) 1
+  期望值: (string) "1"
+    信息: index is 0`,
+			},
+		},
+		{
+			name: "Assertion.Has",
+			fn: func(m *internal.MockTestingT) {
+				assert.That(m, 1).Has(2)
+			},
+			want: map[string]string{
+				assert.English: "error# Assertion failed: method 'Has' not found on type int",
+				assert.Chinese: "error# 断言失败: 类型 int 上没有方法 'Has'",
+			},
+		},
+		{
+			name: "StringAssertion.Matches",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatString(m, "abc").Matches(`^\d+$`)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected string to match the pattern, but it does not
+  actual: "abc"
+ pattern: "^\\d+$"`,
+				assert.Chinese: `error# 断言失败: 期望字符串匹配模式，但实际不匹配
+  实际值: "abc"
+    模式: "^\\d+$"`,
+			},
+		},
+		{
+			name: "NumberAssertion.InDelta",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatNumber(m, 1.5).InDelta(1.0, 0.1)
+			},
+			want: map[string]string{
+				assert.English: "error# Assertion failed: expected number to be within ±0.1 of 1, but it is 1.5",
+				assert.Chinese: "error# 断言失败: 期望数字在 1 的 ±0.1 范围内，但实际为 1.5",
+			},
+		},
+		{
+			name: "SliceAssertion.Equal",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatSlice(m, []int{1, 2}).Equal([]int{1, 3})
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected slices to be equal, but values at index 1 are different
+  actual: [1,2]
+expected: [1,3]`,
+				assert.Chinese: `error# 断言失败: 期望切片相等，但索引 1 处的值不同
+  实际值: [1,2]
+  期望值: [1,3]`,
+			},
+		},
+		{
+			name: "MapAssertion.ContainsKeyValue",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatMap(m, map[string]int{"a": 1}).ContainsKeyValue("a", 2)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected value 2 for key 'a', but got 1 instead
+  actual: {"a":1}`,
+				assert.Chinese: `error# 断言失败: 期望键 'a' 的值为 2，但实际为 1
+  实际值: {"a":1}`,
+			},
+		},
+		{
+			name: "ErrorAssertion.Is",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatError(m, errors.New("other")).Is(errTarget)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected error to be target (according to errors.Is), but they are different
+  actual: other
+expected: target`,
+				assert.Chinese: `error# 断言失败: 期望错误为目标错误（按 errors.Is 判断），但实际不是
+  实际值: other
+  期望值: target`,
+			},
+		},
+		{
+			name: "Assertion.Field",
+			fn: func(m *internal.MockTestingT) {
+				assert.That(m, struct{ ID int }{1}).Field("Name")
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: field 'Name' not found on type struct { ID int }`,
+				assert.Chinese: `error# 断言失败: 类型 struct { ID int } 上没有字段 'Name'`,
+			},
+		},
+		{
+			name: "OrderedAssertion.Between",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatOrdered(m, "b").Between("c", "d")
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected value to be in ["c", "d"], but it is "b"`,
+				assert.Chinese: `error# 断言失败: 期望值在 ["c", "d"] 范围内，但实际为 "b"`,
+			},
+		},
+		{
+			name: "BigNumberAssertion.Equal",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatBigInt(m, big.NewInt(1)).Equal(big.NewInt(2))
+			},
+			want: map[string]string{
+				assert.English: "error# Assertion failed: expected number to be equal to 2, but it is 1",
+				assert.Chinese: "error# 断言失败: 期望数字等于 2，但实际为 1",
+			},
+		},
+		{
+			name: "ComplexAssertion.InDelta",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatComplex(m, 1+2i).InDelta(1+3i, 0.5)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected complex number to be within ±0.5 of (1+3i), but it is (1+2i)
+ abs err: 1`,
+				assert.Chinese: `error# 断言失败: 期望复数在 (1+3i) 的 ±0.5 范围内，但实际为 (1+2i)
+绝对误差: 1`,
+			},
+		},
+		{
+			name: "SamplesAssertion.Mean",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatSamples(m, []float64{1, 2, 3}).Mean().Equal(3)
+			},
+			want: map[string]string{
+				assert.English: "error# Assertion failed: mean: expected number to be equal to 3, but it is 2",
+				assert.Chinese: "error# 断言失败: mean: 期望数字等于 3，但实际为 2",
+			},
+		},
+		{
+			name: "VectorAssertion.InDelta",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatVector(m, []float64{1, 2}).InDelta([]float64{1, 3}, 0.1)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected vector elements to be within ±0.1, but 1 of 2 elements are not
+     [1]: 2, expected 3 (abs err 1)`,
+				assert.Chinese: `error# 断言失败: 期望向量元素在 ±0.1 范围内，但 2 个元素中有 1 个不在范围内
+     [1]: 2，期望 3（绝对误差 1）`,
+			},
+		},
+		{
+			name: "MatrixAssertion.IsIdentity",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatMatrix(m, [][]int{{1, 0}, {1, 1}}).IsIdentity(0)
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected matrix to be the identity, but 1 of 4 elements are not
+  [1][0]: 1, expected 0 (abs err 1)`,
+				assert.Chinese: `error# 断言失败: 期望矩阵为单位矩阵，但 4 个元素中有 1 个不符
+  [1][0]: 1，期望 0（绝对误差 1）`,
+			},
+		},
+		{
+			name: "SliceAssertion.AllUnique",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatSlice(m, []int{1, 2, 1, 2}).AllUnique()
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
+  actual: [1,2,1,2]
+  [0, 2]: 1
+  [1, 3]: 2`,
+				assert.Chinese: `error# 断言失败: 期望切片中的元素互不相同，但发现重复元素
+  实际值: [1,2,1,2]
+  [0, 2]: 1
+  [1, 3]: 2`,
+			},
+		},
+		{
+			name: "SliceAssertion.Extracting",
+			fn: func(m *internal.MockTestingT) {
+				assert.ThatSlice(m, []int{1}).Extracting("ID")
+			},
+			want: map[string]string{
+				assert.English: `error# Assertion failed: cannot extract ID from element 0, as type int has no exported field ID
+  actual: [1]`,
+				assert.Chinese: `error# 断言失败: 无法从元素 0 中提取 ID，因为类型 int 没有导出字段 ID
+  实际值: [1]`,
+			},
+		},
+		{
+			name: "require",
+			fn: func(m *internal.MockTestingT) {
+				require.ThatString(m, "").NotBlank()
+			},
+			want: map[string]string{
+				assert.English: `fatal# Assertion failed: expected string to be non-blank, but it is blank
+  actual: ""`,
+				assert.Chinese: `fatal# 断言失败: 期望字符串不为空白，但实际为空白
+  实际值: ""`,
+			},
+		},
+	}
+
+	for _, lang := range []string{assert.English, assert.Chinese} {
+		t.Run(lang, func(t *testing.T) {
+			assert.SetLanguage(lang)
+			defer assert.SetLanguage("")
+			m := new(internal.MockTestingT)
+			for _, c := range testcases {
+				m.Reset()
+				c.fn(m)
+				assert.ThatString(t, m.String()).As(c.name).Equal(c.want[lang])
+			}
+		})
+	}
+}
+
+func TestFailure_RenderLanguage(t *testing.T) {
+	f := &assert.Failure{
+		Summary:  "期望字符串相等，但实际不相等",
+		Actual:   "{\n  Age: 30,\n}",
+		Expected: "{\n  Age: 31,\n}",
+		Details:  []assert.Detail{{Name: "pattern", Value: `"a.c"`}, {Name: "custom", Value: "x"}},
+		Language: assert.Chinese,
+	}
+	assert.ThatString(t, f.Render(assert.Style{SideBySide: true, Width: 80})).Equal(`期望字符串相等，但实际不相等
+  实际值: {          |   期望值: {
+            Age: 30, |             Age: 31,
+          }          |           }
+    模式: "a.c"
+  custom: x`)
+}
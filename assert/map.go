@@ -17,103 +17,107 @@
 package assert
 
 import (
-	"fmt"
-
 	"github.com/go-spring/gs-assert/internal"
 )
 
 // MapAssertion encapsulates a map value and a test handler for making assertions on the map.
 type MapAssertion[K, V comparable] struct {
 	AssertionBase[*MapAssertion[K, V]]
-	t internal.TestingT
 	v map[K]V
 }
 
 // ThatMap returns a MapAssertion for the given testing object and map value.
 func ThatMap[K, V comparable](t internal.TestingT, v map[K]V) *MapAssertion[K, V] {
-	return &MapAssertion[K, V]{
-		t: t,
-		v: v,
-	}
+	a := &MapAssertion[K, V]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
 }
 
 // Length asserts that the map has the expected length.
-func (a *MapAssertion[K, V]) Length(length int, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) Length(length int, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) != length {
-		str := fmt.Sprintf(`expected map to have length %d, but it has length %d
-  actual: %v`, length, len(a.v), ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.length", length, len(a.v)),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Nil asserts that the map is nil.
-func (a *MapAssertion[K, V]) Nil(msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) Nil(msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if a.v != nil {
-		str := fmt.Sprintf(`expected map to be nil, but it is not
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.nil"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotNil asserts that the map is not nil.
-func (a *MapAssertion[K, V]) NotNil(msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotNil(msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if a.v == nil {
-		str := fmt.Sprintf(`expected map not to be nil, but it is
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.not_nil"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Empty asserts that the map is empty.
-func (a *MapAssertion[K, V]) Empty(msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) Empty(msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) != 0 {
-		str := fmt.Sprintf(`expected map to be empty, but it is not
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.empty"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotEmpty asserts that the map is not empty.
-func (a *MapAssertion[K, V]) NotEmpty(msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotEmpty(msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) == 0 {
-		str := fmt.Sprintf(`expected map to be non-empty, but it is empty
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.not_empty"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Equal asserts that the map is equal to the expected map.
-func (a *MapAssertion[K, V]) Equal(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) Equal(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf(`expected maps to be equal, but their lengths are different
-  actual: %v
-expected: %v`, ToJsonString(a.v), ToJsonString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("map.equal.length"),
+			Actual:   a.json(a.v),
+			Expected: a.json(expect),
+		}, msg...)
 		return a
 	}
 	for k, v := range a.v {
 		if expectV, ok := expect[k]; !ok {
-			str := fmt.Sprintf(`expected maps to be equal, but key '%v' is missing
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.equal.missing_key", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		} else if v != expectV {
-			str := fmt.Sprintf(`expected maps to be equal, but values for key '%v' are different
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.equal.value", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
@@ -121,7 +125,7 @@ expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
 }
 
 // NotEqual asserts that the map is not equal to the expected map.
-func (a *MapAssertion[K, V]) NotEqual(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotEqual(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) == len(expect) {
 		equal := true
@@ -132,58 +136,63 @@ func (a *MapAssertion[K, V]) NotEqual(expect map[K]V, msg ...string) *MapAsserti
 			}
 		}
 		if equal {
-			str := fmt.Sprintf(`expected maps to be different, but they are equal
-  actual: %v`, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("map.not_equal"),
+				Actual:  a.json(a.v),
+			}, msg...)
 		}
 	}
 	return a
 }
 
 // ContainsKey asserts that the map contains the expected key.
-func (a *MapAssertion[K, V]) ContainsKey(key K, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) ContainsKey(key K, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if _, ok := a.v[key]; !ok {
-		str := fmt.Sprintf(`expected map to contain key '%v', but it is missing
-  actual: %v`, key, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.contains_key", key),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotContainsKey asserts that the map does not contain the expected key.
-func (a *MapAssertion[K, V]) NotContainsKey(key K, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotContainsKey(key K, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if _, ok := a.v[key]; ok {
-		str := fmt.Sprintf(`expected map not to contain key '%v', but it is found
-  actual: %v`, key, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.not_contains_key", key),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // ContainsValue asserts that the map contains the expected value.
-func (a *MapAssertion[K, V]) ContainsValue(value V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) ContainsValue(value V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, v := range a.v {
 		if v == value {
 			return a
 		}
 	}
-	str := fmt.Sprintf(`expected map to contain value %+v, but it is missing
-  actual: %v`, value, ToJsonString(a.v))
-	internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	a.Fail(internal.Failure{
+		Summary: a.text("map.contains_value", value),
+		Actual:  a.json(a.v),
+	}, msg...)
 	return a
 }
 
 // NotContainsValue asserts that the map does not contain the expected value.
-func (a *MapAssertion[K, V]) NotContainsValue(value V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotContainsValue(value V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, v := range a.v {
 		if v == value {
-			str := fmt.Sprintf(`expected map not to contain value %+v, but it is found
-  actual: %v`, value, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("map.not_contains_value", value),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
@@ -191,28 +200,31 @@ func (a *MapAssertion[K, V]) NotContainsValue(value V, msg ...string) *MapAssert
 }
 
 // ContainsKeyValue asserts that the map contains the expected key-value pair.
-func (a *MapAssertion[K, V]) ContainsKeyValue(key K, value V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) ContainsKeyValue(key K, value V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if v, ok := a.v[key]; !ok {
-		str := fmt.Sprintf(`expected map to contain key '%v', but it is missing
-  actual: %v`, key, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.contains_key", key),
+			Actual:  a.json(a.v),
+		}, msg...)
 	} else if v != value {
-		str := fmt.Sprintf(`expected value %+v for key '%v', but got %+v instead
-  actual: %v`, value, key, v, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("map.key_value", value, key, v),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // ContainsKeys asserts that the map contains all the expected keys.
-func (a *MapAssertion[K, V]) ContainsKeys(keys []K, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) ContainsKeys(keys []K, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, key := range keys {
 		if _, ok := a.v[key]; !ok {
-			str := fmt.Sprintf(`expected map to contain key '%v', but it is missing
-  actual: %v`, key, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("map.contains_key", key),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
@@ -220,13 +232,14 @@ func (a *MapAssertion[K, V]) ContainsKeys(keys []K, msg ...string) *MapAssertion
 }
 
 // NotContainsKeys asserts that the map does not contain any of the expected keys.
-func (a *MapAssertion[K, V]) NotContainsKeys(keys []K, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotContainsKeys(keys []K, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, key := range keys {
 		if _, ok := a.v[key]; ok {
-			str := fmt.Sprintf(`expected map not to contain key '%v', but it is found
-  actual: %v`, key, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("map.not_contains_key", key),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
@@ -234,7 +247,7 @@ func (a *MapAssertion[K, V]) NotContainsKeys(keys []K, msg ...string) *MapAssert
 }
 
 // ContainsValues asserts that the map contains all the expected values.
-func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, value := range values {
 		found := false
@@ -245,9 +258,10 @@ func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...string) *MapAsser
 			}
 		}
 		if !found {
-			str := fmt.Sprintf(`expected map to contain value %+v, but it is missing
-  actual: %v`, value, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("map.contains_value", value),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
@@ -255,14 +269,15 @@ func (a *MapAssertion[K, V]) ContainsValues(values []V, msg ...string) *MapAsser
 }
 
 // NotContainsValues asserts that the map does not contain any of the expected values.
-func (a *MapAssertion[K, V]) NotContainsValues(values []V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) NotContainsValues(values []V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for _, value := range values {
 		for _, v := range a.v {
 			if v == value {
-				str := fmt.Sprintf(`expected map not to contain value %+v, but it is found
-  actual: %v`, v, ToJsonString(a.v))
-				internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+				a.Fail(internal.Failure{
+					Summary: a.text("map.not_contains_value", v),
+					Actual:  a.json(a.v),
+				}, msg...)
 				return a
 			}
 		}
@@ -271,20 +286,22 @@ func (a *MapAssertion[K, V]) NotContainsValues(values []V, msg ...string) *MapAs
 }
 
 // SubsetOf asserts that the map is a subset of the expected map.
-func (a *MapAssertion[K, V]) SubsetOf(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) SubsetOf(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for k, v := range a.v {
 		if expectV, ok := expect[k]; !ok {
-			str := fmt.Sprintf(`expected map to be a subset, but unexpected key '%v' is found
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.subset.unexpected_key", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		} else if v != expectV {
-			str := fmt.Sprintf(`expected map to be a subset, but values for key '%v' are different
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.subset.value", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
@@ -292,20 +309,22 @@ expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
 }
 
 // SupersetOf asserts that the map is a superset of the expected map.
-func (a *MapAssertion[K, V]) SupersetOf(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) SupersetOf(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	for k, v := range expect {
 		if aV, ok := a.v[k]; !ok {
-			str := fmt.Sprintf(`expected map to be a superset, but key '%v' is missing
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.superset.missing_key", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		} else if aV != v {
-			str := fmt.Sprintf(`expected map to be a superset, but values for key '%v' are different
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.superset.value", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
@@ -313,21 +332,23 @@ expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
 }
 
 // HasSameKeys asserts that the map has the same keys as the expected map.
-func (a *MapAssertion[K, V]) HasSameKeys(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) HasSameKeys(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf(`expected maps to have the same keys, but their lengths are different
-  actual: %v
-expected: %v`, ToJsonString(a.v), ToJsonString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("map.same_keys.length"),
+			Actual:   a.json(a.v),
+			Expected: a.json(expect),
+		}, msg...)
 		return a
 	}
 	for k := range a.v {
 		if _, ok := expect[k]; !ok {
-			str := fmt.Sprintf(`expected maps to have the same keys, but key '%v' is missing
-  actual: %v
-expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.same_keys.missing_key", k),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
@@ -335,13 +356,14 @@ expected: %v`, k, ToJsonString(a.v), ToJsonString(expect))
 }
 
 // HasSameValues asserts that the map has the same values as the expected map.
-func (a *MapAssertion[K, V]) HasSameValues(expect map[K]V, msg ...string) *MapAssertion[K, V] {
+func (a *MapAssertion[K, V]) HasSameValues(expect map[K]V, msg ...any) *MapAssertion[K, V] {
 	a.t.Helper()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf(`expected maps to have the same values, but their lengths are different
-  actual: %v
-expected: %v`, ToJsonString(a.v), ToJsonString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("map.same_values.length"),
+			Actual:   a.json(a.v),
+			Expected: a.json(expect),
+		}, msg...)
 		return a
 	}
 	valueCount := make(map[V]int)
@@ -353,10 +375,11 @@ expected: %v`, ToJsonString(a.v), ToJsonString(expect))
 	}
 	for _, count := range valueCount {
 		if count != 0 {
-			str := fmt.Sprintf(`expected maps to have the same values, but their values are different
-  actual: %v
-expected: %v`, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("map.same_values"),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
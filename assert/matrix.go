@@ -0,0 +1,244 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// MatrixAssertion encapsulates a numeric matrix, stored as a slice of rows,
+// and a test handler for making assertions on it, comparing elements within
+// a tolerance.
+type MatrixAssertion[T Number] struct {
+	AssertionBase[*MatrixAssertion[T]]
+	v [][]T
+}
+
+// ThatMatrix returns a MatrixAssertion for the given testing object and matrix.
+func ThatMatrix[T Number](t internal.TestingT, v [][]T) *MatrixAssertion[T] {
+	a := &MatrixAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// Shape asserts that the matrix has the expected numbers of rows and columns.
+func (a *MatrixAssertion[T]) Shape(rows, cols int, msg ...any) *MatrixAssertion[T] {
+	a.t.Helper()
+	s := a.checkRows()
+	if s == "" && (len(a.v) != rows || a.cols() != cols) {
+		s = a.text("matrix.shape", rows, cols, len(a.v), a.cols())
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// InDelta asserts that each element of the matrix is within the delta range
+// of the expected element, like NumberAssertion.InDelta. Only the elements
+// out of range are printed in the failure.
+func (a *MatrixAssertion[T]) InDelta(expect [][]T, delta T, msg ...any) *MatrixAssertion[T] {
+	a.t.Helper()
+	if s := a.checkShape(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	cells := a.mismatches(expect, func(x, y T) bool { return inDelta(x, y, delta) })
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("matrix.in_delta", delta, len(cells), len(a.v)*a.cols()),
+			Details: mismatchDetails(a.text, cells, false),
+		}, msg...)
+	}
+	return a
+}
+
+// InEpsilon asserts that the relative error of each element of the matrix and
+// the expected element is at most relErr, like NumberAssertion.InEpsilon. Only
+// the elements out of range are printed in the failure.
+func (a *MatrixAssertion[T]) InEpsilon(expect [][]T, relErr float64, msg ...any) *MatrixAssertion[T] {
+	a.t.Helper()
+	if s := a.checkShape(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	cells := a.mismatches(expect, func(x, y T) bool { return inEpsilon(x, y, relErr) })
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("matrix.in_epsilon", formatFloat[T](relErr), len(cells), len(a.v)*a.cols()),
+			Details: mismatchDetails(a.text, cells, true),
+		}, msg...)
+	}
+	return a
+}
+
+// L2Distance returns a NumberAssertion for the Frobenius distance between the
+// matrix and the expected matrix, i.e. the Euclidean distance of their elements,
+// labelled like "m.l2_distance".
+func (a *MatrixAssertion[T]) L2Distance(expect [][]T) *NumberAssertion[float64] {
+	a.t.Helper()
+	if s := a.checkShape(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s})
+		return derivedNumber(&a.AssertionBase, math.NaN(), "l2_distance", false)
+	}
+	var sum float64
+	for i, row := range a.v {
+		for j, x := range row {
+			d := float64(x) - float64(expect[i][j])
+			sum += d * d
+		}
+	}
+	return derivedNumber(&a.AssertionBase, math.Sqrt(sum), "l2_distance", true)
+}
+
+// MaxAbsDiff returns a NumberAssertion for the largest absolute difference
+// between the elements of the matrix and the expected matrix, labelled like
+// "m.max_abs_diff".
+func (a *MatrixAssertion[T]) MaxAbsDiff(expect [][]T) *NumberAssertion[float64] {
+	a.t.Helper()
+	if s := a.checkShape(expect); s != "" {
+		a.Fail(internal.Failure{Summary: s})
+		return derivedNumber(&a.AssertionBase, math.NaN(), "max_abs_diff", false)
+	}
+	var d float64
+	for i, row := range a.v {
+		for j, x := range row {
+			d = maxAbsDiff(d, x, expect[i][j])
+		}
+	}
+	return derivedNumber(&a.AssertionBase, d, "max_abs_diff", true)
+}
+
+// IsSymmetric asserts that the matrix is square and each element is within the
+// delta range of its transposed element. The elements above the diagonal that
+// differ from their transposed elements are printed in the failure.
+func (a *MatrixAssertion[T]) IsSymmetric(delta T, msg ...any) *MatrixAssertion[T] {
+	a.t.Helper()
+	if s := a.checkSquare(); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	var cells []mismatch[T]
+	for i, row := range a.v {
+		for j := i + 1; j < len(row); j++ {
+			if !inDelta(row[j], a.v[j][i], delta) {
+				cells = append(cells, mismatch[T]{index: matrixIndex(i, j), actual: row[j], expect: a.v[j][i]})
+			}
+		}
+	}
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("matrix.symmetric", len(cells)),
+			Details: mismatchDetails(a.text, cells, false),
+		}, msg...)
+	}
+	return a
+}
+
+// IsIdentity asserts that the matrix is square and each element is within the
+// delta range of the element of the identity matrix. Only the elements out of
+// range are printed in the failure.
+func (a *MatrixAssertion[T]) IsIdentity(delta T, msg ...any) *MatrixAssertion[T] {
+	a.t.Helper()
+	if s := a.checkSquare(); s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+		return a
+	}
+	identity := make([][]T, len(a.v))
+	for i := range identity {
+		identity[i] = make([]T, len(a.v))
+		identity[i][i] = 1
+	}
+	cells := a.mismatches(identity, func(x, y T) bool { return inDelta(x, y, delta) })
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("matrix.identity", len(cells), len(a.v)*len(a.v)),
+			Details: mismatchDetails(a.text, cells, false),
+		}, msg...)
+	}
+	return a
+}
+
+// cols returns the number of columns of the matrix, i.e. the length of its
+// first row, 0 if it has no rows.
+func (a *MatrixAssertion[T]) cols() int {
+	if len(a.v) == 0 {
+		return 0
+	}
+	return len(a.v[0])
+}
+
+// checkRows returns the failure summary if the rows of the matrix have
+// different lengths, "" otherwise.
+func (a *MatrixAssertion[T]) checkRows() string {
+	for i, row := range a.v {
+		if len(row) != a.cols() {
+			return a.text("matrix.jagged", i, len(row), a.cols())
+		}
+	}
+	return ""
+}
+
+// checkShape returns the failure summary if the matrix doesn't have the same
+// shape as the expected matrix, "" otherwise.
+func (a *MatrixAssertion[T]) checkShape(expect [][]T) string {
+	if s := a.checkRows(); s != "" {
+		return s
+	}
+	if len(a.v) != len(expect) {
+		return a.text("matrix.rows", len(expect), len(a.v))
+	}
+	for i, row := range expect {
+		if len(row) != a.cols() {
+			return a.text("matrix.row_length", i, len(row), a.cols())
+		}
+	}
+	return ""
+}
+
+// checkSquare returns the failure summary if the matrix is not square, "" otherwise.
+func (a *MatrixAssertion[T]) checkSquare() string {
+	if s := a.checkRows(); s != "" {
+		return s
+	}
+	if len(a.v) != a.cols() {
+		return a.text("matrix.square", len(a.v), a.cols())
+	}
+	return ""
+}
+
+// mismatches returns the elements of the matrix that don't match the elements
+// of the expected matrix of the same shape, in row-major order.
+func (a *MatrixAssertion[T]) mismatches(expect [][]T, match func(x, y T) bool) []mismatch[T] {
+	var cells []mismatch[T]
+	for i, row := range a.v {
+		for j, x := range row {
+			if !match(x, expect[i][j]) {
+				cells = append(cells, mismatch[T]{index: matrixIndex(i, j), actual: x, expect: expect[i][j]})
+			}
+		}
+	}
+	return cells
+}
+
+// matrixIndex formats the index of a matrix element, like "[1][2]".
+func matrixIndex(i, j int) string {
+	return "[" + strconv.Itoa(i) + "][" + strconv.Itoa(j) + "]"
+}
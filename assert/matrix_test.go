@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestMatrix_Shape(t *testing.T) {
+	m := new(internal.MockTestingT)
+	a := [][]float64{{1, 2, 3}, {4, 5, 6}}
+
+	m.Reset()
+	assert.ThatMatrix(m, a).Shape(2, 3)
+	assert.ThatMatrix(m, [][]int{}).Shape(0, 0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatMatrix(m, a).Shape(3, 2)
+	assert.ThatMatrix(m, [][]int{{1, 2}, {3}}).Shape(2, 2)
+	assert.ThatMatrix(m, a).InDelta([][]float64{{1, 2, 3}}, 0)
+	assert.ThatMatrix(m, a).InDelta([][]float64{{1, 2, 3}, {4, 5}}, 0)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: a: expected matrix to have shape 3x2, but it has shape 2x3` +
+		`error# Assertion failed: expected matrix rows to have the same length, but row 1 has length 1 instead of 2` +
+		`error# Assertion failed: a: expected matrix to have 1 rows, but it has 2 rows` +
+		`error# Assertion failed: a: expected row 1 to have length 2, but it has length 3`)
+}
+
+func TestMatrix_InDelta(t *testing.T) {
+	m := new(internal.MockTestingT)
+	a := [][]float64{{1, 2}, {3, 4}}
+
+	m.Reset()
+	assert.ThatMatrix(m, a).InDelta([][]float64{{1.1, 2}, {3, 3.9}}, 0.1)
+	assert.ThatMatrix(m, a).InEpsilon([][]float64{{1, 2.02}, {3, 4}}, 0.01)
+	assert.ThatMatrix(m, a).L2Distance([][]float64{{1, 2}, {6, 8}}).Equal(5)
+	assert.ThatMatrix(m, a).MaxAbsDiff([][]float64{{1, 2}, {6, 8}}).Equal(4)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatMatrix(m, a).InDelta([][]float64{{1, 2.5}, {3, 3}}, 0.1)
+	assert.ThatMatrix(m, a).InEpsilon([][]float64{{1, 2.5}, {3, 4}}, 0.1)
+	assert.ThatMatrix(m, a).MaxAbsDiff([][]float64{{1, 2}, {3, 3}}).Equal(0)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: a: expected matrix elements to be within ±0.1, but 2 of 4 elements are not
+  [0][1]: 2, expected 2.5 (abs err 0.5)
+  [1][1]: 4, expected 3 (abs err 1)` + `error# Assertion failed: a: expected matrix elements to be within relative error 0.1, but 1 of 4 elements are not
+  [0][1]: 2, expected 2.5 (rel err 0.2)` +
+		`error# Assertion failed: a.max_abs_diff: expected number to be equal to 0, but it is 1`)
+}
+
+func TestMatrix_IsSymmetric(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatMatrix(m, [][]float64{{1, 2}, {2.05, 1}}).IsSymmetric(0.1)
+	assert.ThatMatrix(m, [][]int{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}).IsIdentity(0).IsSymmetric(0)
+	assert.ThatMatrix(m, [][]float64{}).IsIdentity(0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	rotation := [][]float64{{0, -1, 0}, {1, 0, 0}, {0, 0, 1}}
+	assert.ThatMatrix(m, rotation).IsSymmetric(0)
+	assert.ThatMatrix(m, rotation).IsIdentity(1e-9)
+	assert.ThatMatrix(m, [][]float64{{1, 0}}).IsIdentity(0)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: rotation: expected matrix to be symmetric, but 1 elements differ from their transposed elements
+  [0][1]: -1, expected 1 (abs err 2)` + `error# Assertion failed: rotation: expected matrix to be the identity, but 4 of 9 elements are not
+  [0][0]: 0, expected 1 (abs err 1)
+  [0][1]: -1, expected 0 (abs err 1)
+  [1][0]: 1, expected 0 (abs err 1)
+  [1][1]: 0, expected 1 (abs err 1)` +
+		`error# Assertion failed: expected matrix to be square, but it has shape 1x2`)
+}
@@ -17,7 +17,10 @@
 package assert
 
 import (
-	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
 
 	"github.com/go-spring/gs-assert/internal"
 )
@@ -29,184 +32,304 @@ type Number interface {
 // NumberAssertion encapsulates a number value and a test handler for making assertions on the number.
 type NumberAssertion[T Number] struct {
 	AssertionBase[*NumberAssertion[T]]
-	t internal.TestingT
 	v T
 }
 
 // ThatNumber returns a NumberAssertion for the given testing object and number value.
 func ThatNumber[T Number](t internal.TestingT, v T) *NumberAssertion[T] {
-	return &NumberAssertion[T]{
-		t: t,
-		v: v,
+	a := &NumberAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// equal reports whether the number value is equal to the expected value.
+// Floats are compared within the relative tolerance configured for the test.
+func (a *NumberAssertion[T]) equal(expect T) bool {
+	if a.v == expect {
+		return true
+	}
+	tol := internal.ConfigFor(a.t).FloatTolerance
+	if tol <= 0 {
+		return false
+	}
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Float32, reflect.Float64:
+		x, y := float64(a.v), float64(expect)
+		return math.Abs(x-y) <= tol*max(math.Abs(x), math.Abs(y))
+	default:
+		return false
 	}
 }
 
 // Equal asserts that the number value is equal to the expected value.
-func (a *NumberAssertion[T]) Equal(expect T, msg ...string) *NumberAssertion[T] {
+// Floats are compared within the relative tolerance configured by
+// Config.FloatTolerance, exactly by default.
+func (a *NumberAssertion[T]) Equal(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
-	if a.v != expect {
-		str := fmt.Sprintf(`expected number to be equal to %v, but it is %v`, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	if !a.equal(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("number.equal", expect, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotEqual asserts that the number value is not equal to the expected value.
-func (a *NumberAssertion[T]) NotEqual(expect T, msg ...string) *NumberAssertion[T] {
+// Floats are compared within the relative tolerance configured by
+// Config.FloatTolerance, exactly by default.
+func (a *NumberAssertion[T]) NotEqual(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
-	if a.v == expect {
-		str := fmt.Sprintf(`expected number not to be equal to %v, but it is`, expect)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	if a.equal(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("number.not_equal", expect),
+		}, msg...)
 	}
 	return a
 }
 
 // GreaterThan asserts that the number value is greater than the expected value.
-func (a *NumberAssertion[T]) GreaterThan(expect T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) GreaterThan(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v <= expect {
-		str := fmt.Sprintf(`expected number to be greater than %v, but it is %v`, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.greater_than", expect, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // GreaterOrEqual asserts that the number value is greater than or equal to the expected value.
-func (a *NumberAssertion[T]) GreaterOrEqual(expect T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) GreaterOrEqual(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v < expect {
-		str := fmt.Sprintf(`expected number to be greater than or equal to %v, but it is %v`, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.greater_or_equal", expect, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // LessThan asserts that the number value is less than the expected value.
-func (a *NumberAssertion[T]) LessThan(expect T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) LessThan(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v >= expect {
-		str := fmt.Sprintf(`expected number to be less than %v, but it is %v`, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.less_than", expect, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // LessOrEqual asserts that the number value is less than or equal to the expected value.
-func (a *NumberAssertion[T]) LessOrEqual(expect T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) LessOrEqual(expect T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v > expect {
-		str := fmt.Sprintf(`expected number to be less than or equal to %v, but it is %v`, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.less_or_equal", expect, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Zero asserts that the number value is zero.
-func (a *NumberAssertion[T]) Zero(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) Zero(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v != 0 {
-		str := fmt.Sprintf(`expected number to be zero, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.zero", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotZero asserts that the number value is not zero.
-func (a *NumberAssertion[T]) NotZero(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) NotZero(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v == 0 {
-		str := fmt.Sprintf(`expected number not to be zero, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.not_zero", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Positive asserts that the number value is positive.
-func (a *NumberAssertion[T]) Positive(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) Positive(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v <= 0 {
-		str := fmt.Sprintf(`expected number to be positive, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.positive", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotPositive asserts that the number value is non-positive.
-func (a *NumberAssertion[T]) NotPositive(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) NotPositive(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v > 0 {
-		str := fmt.Sprintf(`expected number to be non-positive, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.not_positive", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Negative asserts that the number value is negative.
-func (a *NumberAssertion[T]) Negative(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) Negative(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v >= 0 {
-		str := fmt.Sprintf(`expected number to be negative, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.negative", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotNegative asserts that the number value is non-negative.
-func (a *NumberAssertion[T]) NotNegative(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) NotNegative(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v < 0 {
-		str := fmt.Sprintf(`expected number to be non-negative, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.not_negative", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Between asserts that the number value is between the lower and upper bounds.
-func (a *NumberAssertion[T]) Between(lower, upper T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) Between(lower, upper T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v < lower || a.v > upper {
-		str := fmt.Sprintf(`expected number to be between %v and %v, but it is %v`, lower, upper, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.between", lower, upper, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotBetween asserts that the number value is not between the lower and upper bounds.
-func (a *NumberAssertion[T]) NotBetween(lower, upper T, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) NotBetween(lower, upper T, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if a.v >= lower && a.v <= upper {
-		str := fmt.Sprintf(`expected number not to be between %v and %v, but it is %v`, lower, upper, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.not_between", lower, upper, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // InDelta asserts that the number value is within the delta range of the expected value.
-func (a *NumberAssertion[T]) InDelta(expect T, delta T, msg ...string) *NumberAssertion[T] {
+// For floats, the rounding error of the values is tolerated, so that 5.2 is within
+// 0.2 of 5.0. NaN is never within the range, and infinities only match themselves.
+func (a *NumberAssertion[T]) InDelta(expect T, delta T, msg ...any) *NumberAssertion[T] {
+	a.t.Helper()
+	if !inDelta(a.v, expect, delta) {
+		a.Fail(internal.Failure{
+			Summary: a.text("number.in_delta", delta, expect, a.v),
+		}, msg...)
+	}
+	return a
+}
+
+// InEpsilon asserts that the relative error between the number value and the
+// expected value, i.e. |actual-expect| / max(|actual|, |expect|), is at most
+// relErr. NaN never matches, infinities only match themselves, and +0 matches -0.
+func (a *NumberAssertion[T]) InEpsilon(expect T, relErr float64, msg ...any) *NumberAssertion[T] {
+	a.t.Helper()
+	if a.v != expect {
+		abs, rel := relativeError(a.v, expect)
+		if !(rel <= relErr) {
+			a.Fail(internal.Failure{
+				Summary: a.text("number.in_epsilon", formatFloat[T](relErr), expect, a.v),
+				Details: a.errorDetails(abs, rel),
+			}, msg...)
+		}
+	}
+	return a
+}
+
+// WithinULPs asserts that the number value is at most n units in the last place
+// away from the expected value, i.e. there are at most n-1 representable values
+// of its type between them. Subnormals are counted like normal values, +0 and -0
+// are the same value, NaN never matches and infinities only match themselves.
+// For integers, the unit in the last place is 1.
+func (a *NumberAssertion[T]) WithinULPs(expect T, n uint64, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
-	diff := a.v - expect
-	if diff < 0 {
-		diff = -diff
+	if d, ok := ulps(a.v, expect); !ok || d > n {
+		abs, rel := relativeError(a.v, expect)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.within_ulps", n, expect, a.v),
+			Details: append(a.errorDetails(abs, rel), a.ulpsDetail(d, ok)),
+		}, msg...)
 	}
-	if diff > delta { // todo (lvan100) 精度问题
-		str := fmt.Sprintf(`expected number to be within ±%v of %v, but it is %v`, delta, expect, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	return a
+}
+
+// ApproxEqual asserts that the number value is approximately equal to the
+// expected value, i.e. within 4 units in the last place or within the relative
+// tolerance configured by Config.FloatTolerance, which defaults to 1e-9 for
+// float64 and 1e-6 for float32. Integers must be equal. Compare values near
+// zero with InDelta, since their relative error is large.
+func (a *NumberAssertion[T]) ApproxEqual(expect T, msg ...any) *NumberAssertion[T] {
+	a.t.Helper()
+	d, ok := ulps(a.v, expect)
+	abs, rel := relativeError(a.v, expect)
+	if ok && (d == 0 || isFloat[T]() && (d <= approxULPs || rel <= a.tolerance())) {
+		return a
 	}
+	a.Fail(internal.Failure{
+		Summary: a.text("number.approx_equal", expect, a.v),
+		Details: append(a.errorDetails(abs, rel), a.ulpsDetail(d, ok)),
+	}, msg...)
 	return a
 }
 
+// approxULPs is the distance in units in the last place within which
+// ApproxEqual always considers floats equal.
+const approxULPs = 4
+
+// tolerance returns the relative tolerance of ApproxEqual for the test.
+func (a *NumberAssertion[T]) tolerance() float64 {
+	if tol := internal.ConfigFor(a.t).FloatTolerance; tol > 0 {
+		return tol
+	}
+	if reflect.TypeFor[T]().Kind() == reflect.Float32 {
+		return 1e-6
+	}
+	return 1e-9
+}
+
+// errorDetails returns the absolute and relative errors as failure details.
+func (a *NumberAssertion[T]) errorDetails(abs, rel float64) []internal.Detail {
+	return []internal.Detail{
+		{Name: "abs_error", Value: formatFloat[T](abs)},
+		{Name: "rel_error", Value: formatFloat[T](rel)},
+	}
+}
+
+// ulpsDetail returns the distance in units in the last place as a failure
+// detail, "NaN" if it's not defined.
+func (a *NumberAssertion[T]) ulpsDetail(d uint64, ok bool) internal.Detail {
+	if !ok {
+		return internal.Detail{Name: "ulps", Value: "NaN"}
+	}
+	return internal.Detail{Name: "ulps", Value: strconv.FormatUint(d, 10)}
+}
+
 // IsNaN asserts that the number value is NaN (Not a Number).
-func (a *NumberAssertion[T]) IsNaN(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) IsNaN(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if !isNaN(a.v) {
-		str := fmt.Sprintf(`expected number to be NaN, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.nan", a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // IsInf asserts that the number value is infinite.
-func (a *NumberAssertion[T]) IsInf(sign int, msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) IsInf(sign int, msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if !isInf(a.v, sign) {
 		var c string
@@ -215,22 +338,36 @@ func (a *NumberAssertion[T]) IsInf(sign int, msg ...string) *NumberAssertion[T]
 		} else {
 			c = "-"
 		}
-		str := fmt.Sprintf(`expected number to be %sInf, but it is %v`, c, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.inf", c, a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // IsFinite asserts that the number value is finite.
-func (a *NumberAssertion[T]) IsFinite(msg ...string) *NumberAssertion[T] {
+func (a *NumberAssertion[T]) IsFinite(msg ...any) *NumberAssertion[T] {
 	a.t.Helper()
 	if isNaN(a.v) || isInf(a.v, 0) {
-		str := fmt.Sprintf(`expected number to be finite, but it is %v`, a.v)
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("number.finite", a.v),
+		}, msg...)
 	}
 	return a
 }
 
+// derivedNumber returns a NumberAssertion for a value derived from the value
+// of the parent assertion, such as a statistic of samples, labelled like
+// "latencies.mean". If the value is not defined, e.g. as there are too few
+// samples, the returned assertion only records its failures, since the parent
+// reports why it's not defined.
+func derivedNumber[P any, V Number](parent *AssertionBase[P], v V, label string, defined bool) *NumberAssertion[V] {
+	n := &NumberAssertion[V]{v: v}
+	n.AssertionBase = nested(parent, n, label)
+	n.checkOnly = n.checkOnly || !defined
+	return n
+}
+
 // isNaN checks if the value is NaN.
 func isNaN[T Number](v T) bool {
 	switch any(v).(type) {
@@ -261,3 +398,323 @@ const (
 	maxFloat32 = 3.4028234663852886e+38
 	maxFloat64 = 1.7976931348623157e+308
 )
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T Number]() bool {
+	k := reflect.TypeFor[T]().Kind()
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// epsilon returns the machine epsilon of T, 0 for integers.
+func epsilon[T Number]() float64 {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Float32:
+		return 0x1p-23
+	case reflect.Float64:
+		return 0x1p-52
+	default:
+		return 0
+	}
+}
+
+// formatFloat formats a float computed from values of T in the shortest
+// form that represents it at the precision of T.
+func formatFloat[T Number](f float64) string {
+	if reflect.TypeFor[T]().Kind() == reflect.Float32 {
+		return strconv.FormatFloat(f, 'g', -1, 32)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// inDelta reports whether |x-y| <= delta. For floats, the difference may
+// exceed delta by the rounding error of the values, a few epsilons of their
+// magnitude, so that the decimal literals 5.2 and 5.0 are within 0.2.
+func inDelta[T Number](x, y, delta T) bool {
+	if x == y {
+		return true
+	}
+	if !isFloat[T]() {
+		return intDistance(x, y) <= intDistance(delta, 0)
+	}
+	fx, fy, fd := float64(x), float64(y), float64(delta)
+	diff := math.Abs(fx - fy)
+	if math.IsNaN(diff) || math.IsInf(diff, 0) {
+		return false
+	}
+	slack := 2 * epsilon[T]() * max(math.Abs(fx), math.Abs(fy), math.Abs(fd))
+	return diff <= fd+slack
+}
+
+// relativeError returns the absolute error |x-y| and the relative error
+// |x-y| / max(|x|, |y|) of x and y, which is 0 if both are zero.
+func relativeError[T Number](x, y T) (abs, rel float64) {
+	if isFloat[T]() {
+		abs = math.Abs(float64(x) - float64(y))
+	} else {
+		abs = float64(intDistance(x, y))
+	}
+	if abs == 0 {
+		return 0, 0
+	}
+	return abs, abs / max(math.Abs(float64(x)), math.Abs(float64(y)))
+}
+
+// intDistance returns |x-y| for integers, without overflow.
+func intDistance[T Number](x, y T) uint64 {
+	if x < y {
+		x, y = y, x
+	}
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(int64(x)) - uint64(int64(y))
+	default:
+		return uint64(x) - uint64(y)
+	}
+}
+
+// ulps returns the distance of x and y in units in the last place of T,
+// i.e. the number of representable values of T from x to y. It reports
+// false if it's not defined, i.e. if any is NaN or only one is infinite.
+func ulps[T Number](x, y T) (uint64, bool) {
+	if isNaN(x) || isNaN(y) {
+		return 0, false
+	}
+	if x == y {
+		return 0, true
+	}
+	if isInf(x, 0) || isInf(y, 0) {
+		return 0, false
+	}
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Float32:
+		bx, by := math.Float32bits(float32(x)), math.Float32bits(float32(y))
+		return ordinalDistance(uint64(bx)<<32, uint64(by)<<32) >> 32, true
+	case reflect.Float64:
+		return ordinalDistance(math.Float64bits(float64(x)), math.Float64bits(float64(y))), true
+	default:
+		return intDistance(x, y), true
+	}
+}
+
+// ordinalDistance returns the number of float64 values between the bit
+// patterns x and y. Floats of the same sign are ordered like their bits,
+// and the distance of floats of different signs goes through zero.
+func ordinalDistance(x, y uint64) uint64 {
+	const sign = 1 << 63
+	mx, my := x&^sign, y&^sign
+	if x&sign != y&sign {
+		return mx + my
+	}
+	return max(mx, my) - min(mx, my)
+}
+
+// AnyNumberAssertion encapsulates a number value and a test handler for making
+// assertions on the number against expected values of any numeric type.
+type AnyNumberAssertion[T Number] struct {
+	AssertionBase[*AnyNumberAssertion[T]]
+	v T
+}
+
+// ThatAnyNumber returns an AnyNumberAssertion for the given testing object and
+// number value. Unlike NumberAssertion, the expected values may be of any numeric
+// type, and are compared exactly with the value, without conversion, e.g.
+//
+//	assert.ThatAnyNumber(t, uint64(n)).GreaterThan(-1)
+//	assert.ThatAnyNumber(t, int64(1<<53+1)).NotEqual(float64(1 << 53))
+func ThatAnyNumber[T Number](t internal.TestingT, v T) *AnyNumberAssertion[T] {
+	a := &AnyNumberAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// unordered is the result of compareExact if any of the numbers is NaN.
+const unordered = 2
+
+// exact returns the exact values of the number value and the expected values,
+// nil for NaN. If an expected value is not a number, it returns the summary of
+// the failure instead.
+func (a *AnyNumberAssertion[T]) exact(expect ...any) ([]*big.Float, string) {
+	x, _ := exactNumber(a.v)
+	ret := []*big.Float{x}
+	for _, e := range expect {
+		y, ok := exactNumber(e)
+		if !ok {
+			return nil, a.text("number.not_number", e, e)
+		}
+		ret = append(ret, y)
+	}
+	return ret, ""
+}
+
+// Equal asserts that the number value is equal to the expected number.
+func (a *AnyNumberAssertion[T]) Equal(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" && compareExact(v[0], v[1]) != 0 {
+		s = a.text("number.equal", expect, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotEqual asserts that the number value is not equal to the expected number.
+func (a *AnyNumberAssertion[T]) NotEqual(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" && compareExact(v[0], v[1]) == 0 {
+		s = a.text("number.not_equal", expect)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// GreaterThan asserts that the number value is greater than the expected number.
+func (a *AnyNumberAssertion[T]) GreaterThan(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" && compareExact(v[0], v[1]) != 1 {
+		s = a.text("number.greater_than", expect, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// GreaterOrEqual asserts that the number value is greater than or equal to the expected number.
+func (a *AnyNumberAssertion[T]) GreaterOrEqual(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" {
+		if c := compareExact(v[0], v[1]); c != 0 && c != 1 {
+			s = a.text("number.greater_or_equal", expect, a.v)
+		}
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// LessThan asserts that the number value is less than the expected number.
+func (a *AnyNumberAssertion[T]) LessThan(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" && compareExact(v[0], v[1]) != -1 {
+		s = a.text("number.less_than", expect, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// LessOrEqual asserts that the number value is less than or equal to the expected number.
+func (a *AnyNumberAssertion[T]) LessOrEqual(expect any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect)
+	if s == "" {
+		if c := compareExact(v[0], v[1]); c != 0 && c != -1 {
+			s = a.text("number.less_or_equal", expect, a.v)
+		}
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// Between asserts that the number value is between the lower and upper bounds.
+func (a *AnyNumberAssertion[T]) Between(lower, upper any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(lower, upper)
+	if s == "" && !betweenExact(v[0], v[1], v[2]) {
+		s = a.text("number.between", lower, upper, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// NotBetween asserts that the number value is not between the lower and upper bounds.
+func (a *AnyNumberAssertion[T]) NotBetween(lower, upper any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(lower, upper)
+	if s == "" && betweenExact(v[0], v[1], v[2]) {
+		s = a.text("number.not_between", lower, upper, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// InDelta asserts that the exact difference between the number value and the
+// expected number is at most the absolute value of delta. NaN is never within
+// the range, and infinities only match themselves.
+func (a *AnyNumberAssertion[T]) InDelta(expect, delta any, msg ...any) *AnyNumberAssertion[T] {
+	a.t.Helper()
+	v, s := a.exact(expect, delta)
+	if s == "" && !inDeltaExact(v[0], v[1], v[2]) {
+		s = a.text("number.in_delta", delta, expect, a.v)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s}, msg...)
+	}
+	return a
+}
+
+// exactNumber returns the exact value of a number of any numeric type,
+// nil for NaN. It reports false if v is not a number.
+func exactNumber(v any) (*big.Float, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); !math.IsNaN(f) {
+			return new(big.Float).SetFloat64(f), true
+		}
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// compareExact compares x and y like big.Float.Cmp, and returns unordered
+// if any of them is NaN, i.e. nil.
+func compareExact(x, y *big.Float) int {
+	if x == nil || y == nil {
+		return unordered
+	}
+	return x.Cmp(y)
+}
+
+// betweenExact reports whether lower <= x <= upper.
+func betweenExact(x, lower, upper *big.Float) bool {
+	c1, c2 := compareExact(x, lower), compareExact(x, upper)
+	return (c1 == 0 || c1 == 1) && (c2 == 0 || c2 == -1)
+}
+
+// exactPrec is the precision of the exact difference of any two numbers,
+// from the largest float64 to the smallest subnormal, with 64-bit integers.
+const exactPrec = 1024 + 1074 + 64
+
+// inDeltaExact reports whether |x-y| <= |delta|.
+func inDeltaExact(x, y, delta *big.Float) bool {
+	if x == nil || y == nil || delta == nil {
+		return false
+	}
+	if x.IsInf() || y.IsInf() {
+		return x.Cmp(y) == 0
+	}
+	diff := new(big.Float).SetPrec(exactPrec).Sub(x, y)
+	return diff.Abs(diff).Cmp(new(big.Float).Abs(delta)) <= 0
+}
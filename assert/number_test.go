@@ -38,7 +38,7 @@ func TestNumber_Equal(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().Equal(10, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be equal to 10, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -125,7 +125,7 @@ func TestNumber_NotEqual(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().NotEqual(5, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number not to be equal to 5, but it is
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -221,7 +221,7 @@ func TestNumber_GreaterThan(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().GreaterThan(10, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be greater than 10, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -326,7 +326,7 @@ func TestNumber_GreaterOrEqual(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().GreaterOrEqual(10, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be greater than or equal to 10, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case (greater), one (equal) and one failure case for each type
 	m.Reset()
@@ -434,7 +434,7 @@ func TestNumber_LessThan(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 10).Require().LessThan(5, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be less than 5, but it is 10
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -539,7 +539,7 @@ func TestNumber_LessOrEqual(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 10).Require().LessOrEqual(5, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be less than or equal to 5, but it is 10
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case (less), one (equal) and one failure case for each type
 	m.Reset()
@@ -647,7 +647,7 @@ func TestNumber_Zero(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().Zero("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be zero, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -762,7 +762,7 @@ func TestNumber_NotZero(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 0).Require().NotZero("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number not to be zero, but it is 0
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success and one failure case for each type
 	m.Reset()
@@ -878,7 +878,7 @@ func TestNumber_Positive(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, -5).Require().Positive("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be positive, but it is -5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case, one zero case and one negative case for each type
 	m.Reset()
@@ -1013,7 +1013,7 @@ func TestNumber_NotPositive(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().NotPositive("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be non-positive, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one negative case, one zero case, and one positive case for each type
 	m.Reset()
@@ -1143,7 +1143,7 @@ func TestNumber_Negative(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().Negative("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be negative, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one negative case, one zero case, and one positive case for each type
 	m.Reset()
@@ -1279,7 +1279,7 @@ func TestNumber_NotNegative(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, -5).Require().NotNegative("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be non-negative, but it is -5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one positive case, one zero case, and one negative case for each type
 	m.Reset()
@@ -1409,7 +1409,7 @@ func TestNumber_Between(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 0).Require().Between(1, 10, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be between 1 and 10, but it is 0
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case and one failure case (below lower bound) for each type
 	m.Reset()
@@ -1554,7 +1554,7 @@ func TestNumber_NotBetween(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5).Require().NotBetween(1, 10, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number not to be between 1 and 10, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case and one failure case for each type
 	m.Reset()
@@ -1686,7 +1686,7 @@ func TestNumber_InDelta(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5.6).Require().InDelta(5.0, 0.3, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be within ±0.3 of 5, but it is 5.6
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case and one failure case for each type
 	m.Reset()
@@ -1794,6 +1794,180 @@ func TestNumber_InDelta(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, float64(-1.7)).InDelta(float64(-1.4), float64(0.2))
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±0.2 of -1.4, but it is -1.7`)
+
+	// Test unsigned differences don't wrap around
+	m.Reset()
+	assert.ThatNumber(m, uint8(1)).InDelta(200, 5)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±5 of 200, but it is 1`)
+
+	m.Reset()
+	assert.ThatNumber(m, int8(-128)).InDelta(127, 100)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±100 of 127, but it is -128`)
+
+	m.Reset()
+	assert.ThatNumber(m, uint64(math.MaxUint64)).InDelta(math.MaxUint64-3, 3)
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test the rounding error of the values is tolerated
+	m.Reset()
+	assert.ThatNumber(m, 5.2).InDelta(5.0, 0.2)
+	assert.ThatNumber(m, float32(0.3)).InDelta(0.1, 0.2)
+	assert.ThatNumber(m, 1e9+0.5).InDelta(1e9, 0.5)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 5.2000001).InDelta(5.0, 0.2)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±0.2 of 5, but it is 5.2000001`)
+
+	// Test NaN and infinities
+	m.Reset()
+	assert.ThatNumber(m, math.Inf(1)).InDelta(math.Inf(1), 0.1)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, math.NaN()).InDelta(1.0, 0.1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: math.NaN(): expected number to be within ±0.1 of 1, but it is NaN`)
+
+	m.Reset()
+	assert.ThatNumber(m, math.Inf(1)).InDelta(math.MaxFloat64, math.MaxFloat64)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±1.7976931348623157e+308 of 1.7976931348623157e+308, but it is +Inf`)
+}
+
+func TestNumber_InEpsilon(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	// Test relative error across magnitudes
+	m.Reset()
+	assert.ThatNumber(m, 1.0001e-9).InEpsilon(1e-9, 1e-3)
+	assert.ThatNumber(m, 1.0001e9).InEpsilon(1e9, 1e-3)
+	assert.ThatNumber(m, int64(1001)).InEpsilon(1000, 1e-3)
+	assert.ThatNumber(m, float32(1.0001)).InEpsilon(1, 1e-3)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 1.1e-9).InEpsilon(1e-9, 1e-3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within relative error 0.001 of 1e-09, but it is 1.1e-09
+ abs err: 9.999999999999986e-11
+ rel err: 0.09090909090909079`)
+
+	m.Reset()
+	assert.ThatNumber(m, float32(1.1)).Require().InEpsilon(1, 0.01, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be within relative error 0.01 of 1, but it is 1.1
+ abs err: 0.100000024
+ rel err: 0.09090911
+ message: index is 0`)
+
+	// Test signed zero, infinities and NaN
+	m.Reset()
+	assert.ThatNumber(m, math.Copysign(0, -1)).InEpsilon(0, 0)
+	assert.ThatNumber(m, math.Inf(-1)).InEpsilon(math.Inf(-1), 0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 1e-300).InEpsilon(0, 0.5)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within relative error 0.5 of 0, but it is 1e-300
+ abs err: 1e-300
+ rel err: 1`)
+
+	m.Reset()
+	assert.ThatNumber(m, math.Inf(1)).InEpsilon(math.MaxFloat64, 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within relative error 1 of 1.7976931348623157e+308, but it is +Inf
+ abs err: +Inf
+ rel err: NaN`)
+
+	m.Reset()
+	assert.ThatNumber(m, math.NaN()).InEpsilon(math.NaN(), 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: math.NaN(): expected number to be within relative error 1 of NaN, but it is NaN
+ abs err: NaN
+ rel err: NaN`)
+}
+
+func TestNumber_WithinULPs(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatNumber(m, math.Nextafter(1, 2)).WithinULPs(1, 1)
+	assert.ThatNumber(m, math.Nextafter32(1, 0)).WithinULPs(1, 1)
+	assert.ThatNumber(m, math.Copysign(0, -1)).WithinULPs(0, 0)
+	assert.ThatNumber(m, math.Inf(1)).WithinULPs(math.Inf(1), 0)
+	assert.ThatNumber(m, uint8(200)).WithinULPs(198, 2)
+	assert.ThatString(t, m.String()).Equal("")
+
+	// Test subnormals are counted through zero
+	m.Reset()
+	assert.ThatNumber(m, math.SmallestNonzeroFloat64).WithinULPs(-math.SmallestNonzeroFloat64, 2)
+	assert.ThatNumber(m, float32(math.SmallestNonzeroFloat32)).WithinULPs(0, 1)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 2*math.SmallestNonzeroFloat64).WithinULPs(-math.SmallestNonzeroFloat64, 2)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: 2*math.SmallestNonzeroFloat64: expected number to be within 2 ULPs of -5e-324, but it is 1e-323
+ abs err: 1.5e-323
+ rel err: 1.5
+    ulps: 3`)
+
+	m.Reset()
+	m.Reset()
+	x, y := 0.1, 0.2
+	assert.ThatNumber(m, x+y).WithinULPs(0.3, 0)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: x+y: expected number to be within 0 ULPs of 0.3, but it is 0.30000000000000004
+ abs err: 5.551115123125783e-17
+ rel err: 1.850371707708594e-16
+    ulps: 1`)
+
+	m.Reset()
+	assert.ThatNumber(m, int64(math.MinInt64)).WithinULPs(math.MaxInt64, 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: int64(math.MinInt64): expected number to be within 1 ULPs of 9223372036854775807, but it is -9223372036854775808
+ abs err: 1.8446744073709552e+19
+ rel err: 2
+    ulps: 18446744073709551615`)
+
+	m.Reset()
+	assert.ThatNumber(m, math.Inf(1)).WithinULPs(math.MaxFloat64, 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within 1 ULPs of 1.7976931348623157e+308, but it is +Inf
+ abs err: +Inf
+ rel err: NaN
+    ulps: NaN`)
+}
+
+func TestNumber_ApproxEqual(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	x, y := 0.1, 0.2
+	m.Reset()
+	assert.ThatNumber(m, x+y).ApproxEqual(0.3)
+	assert.ThatNumber(m, 1e9+1e-1).ApproxEqual(1e9)
+	assert.ThatNumber(m, float32(1.0000005)).ApproxEqual(1)
+	assert.ThatNumber(m, math.SmallestNonzeroFloat64).ApproxEqual(0)
+	assert.ThatNumber(m, 3).ApproxEqual(3)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatNumber(m, 1e9+2).ApproxEqual(1e9)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be approximately equal to 1e+09, but it is 1.000000002e+09
+ abs err: 2
+ rel err: 1.999999996e-09
+    ulps: 16777216`)
+
+	m.Reset()
+	assert.ThatNumber(m, 4).ApproxEqual(3)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be approximately equal to 3, but it is 4
+ abs err: 1
+ rel err: 0.25
+    ulps: 1`)
+
+	m.Reset()
+	assert.ThatNumber(m, math.NaN()).ApproxEqual(math.NaN())
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: math.NaN(): expected number to be approximately equal to NaN, but it is NaN
+ abs err: NaN
+ rel err: NaN
+    ulps: NaN`)
+
+	// Test the tolerance configured for the test
+	assert.WithConfig(m, func(c *assert.Config) { c.FloatTolerance = 1e-6 })
+	m.Reset()
+	assert.ThatNumber(m, 1e9+2).ApproxEqual(1e9)
+	assert.ThatString(t, m.String()).Equal("")
 }
 
 func TestNumber_IsNaN(t *testing.T) {
@@ -1810,7 +1984,7 @@ func TestNumber_IsNaN(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5.0).Require().IsNaN("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be NaN, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with float32 NaN
 	m.Reset()
@@ -1898,7 +2072,7 @@ func TestNumber_IsInf(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, 5.0).Require().IsInf(-1, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be -Inf, but it is 5
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with float32 infinity
 	m.Reset()
@@ -1995,11 +2169,11 @@ func TestNumber_IsInf(t *testing.T) {
 	// Test with NaN - should fail
 	m.Reset()
 	assert.ThatNumber(m, math.NaN()).IsInf(1)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be +Inf, but it is NaN`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: math.NaN(): expected number to be +Inf, but it is NaN`)
 
 	m.Reset()
 	assert.ThatNumber(m, float32(math.NaN())).IsInf(-1)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be -Inf, but it is NaN`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: float32(math.NaN()): expected number to be -Inf, but it is NaN`)
 }
 
 func TestNumber_IsFinite(t *testing.T) {
@@ -2024,7 +2198,7 @@ func TestNumber_IsFinite(t *testing.T) {
 	m.Reset()
 	assert.ThatNumber(m, math.Inf(-1)).Require().IsFinite("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number to be finite, but it is -Inf
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with different numeric types - one success case for each type
 	m.Reset()
@@ -2140,11 +2314,11 @@ func TestNumber_IsFinite(t *testing.T) {
 	// Test with NaN - should fail
 	m.Reset()
 	assert.ThatNumber(m, math.NaN()).IsFinite()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be finite, but it is NaN`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: math.NaN(): expected number to be finite, but it is NaN`)
 
 	m.Reset()
 	assert.ThatNumber(m, float32(math.NaN())).IsFinite()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be finite, but it is NaN`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: float32(math.NaN()): expected number to be finite, but it is NaN`)
 
 	// Test with infinity - should fail
 	m.Reset()
@@ -2163,3 +2337,79 @@ func TestNumber_IsFinite(t *testing.T) {
 	assert.ThatNumber(m, float32(math.Inf(-1))).IsFinite()
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be finite, but it is -Inf`)
 }
+
+func TestAnyNumber(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	// Test exact comparisons across signed, unsigned and float types
+	m.Reset()
+	assert.ThatAnyNumber(m, uint64(math.MaxUint64)).
+		GreaterThan(-1).
+		GreaterThan(int64(math.MaxInt64)).
+		GreaterOrEqual(uint64(math.MaxUint64)).
+		LessThan(float64(math.MaxUint64)).
+		NotEqual(float64(math.MaxUint64)).
+		Between(0, 1e20).
+		NotBetween(-1, int8(0))
+	assert.ThatAnyNumber(m, int64(1<<53+1)).NotEqual(float64(1 << 53)).GreaterThan(float32(1 << 53))
+	assert.ThatAnyNumber(m, int8(-1)).LessThan(uint64(0)).LessOrEqual(-1.0).Equal(float32(-1))
+	assert.ThatAnyNumber(m, 0.5).Equal(float32(0.5)).Between(uint8(0), 1)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatAnyNumber(m, uint64(math.MaxUint64)).Equal(-1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: uint64(math.MaxUint64): expected number to be equal to -1, but it is 18446744073709551615`)
+
+	m.Reset()
+	assert.ThatAnyNumber(m, int64(1<<53+1)).Equal(float64(1 << 53))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be equal to 9.007199254740992e+15, but it is 9007199254740993`)
+
+	m.Reset()
+	assert.ThatAnyNumber(m, uint8(0)).LessOrEqual(-1).GreaterThan(0.0).Between(1, 2)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be less than or equal to -1, but it is 0` +
+		`error# Assertion failed: expected number to be greater than 0, but it is 0` +
+		`error# Assertion failed: expected number to be between 1 and 2, but it is 0`)
+
+	m.Reset()
+	assert.ThatAnyNumber(m, -1).Require().NotBetween(int8(-1), uint(1), "index is 0")
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected number not to be between -1 and 1, but it is -1
+ message: index is 0`)
+
+	// Test NaN is unordered
+	m.Reset()
+	nan := math.NaN()
+	assert.ThatAnyNumber(m, nan).NotEqual(nan).NotBetween(math.Inf(-1), math.Inf(1))
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatAnyNumber(m, 1).GreaterOrEqual(nan).LessOrEqual(nan)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be greater than or equal to NaN, but it is 1` +
+		`error# Assertion failed: expected number to be less than or equal to NaN, but it is 1`)
+
+	// Test exact differences
+	m.Reset()
+	assert.ThatAnyNumber(m, uint8(1)).InDelta(200, 199).InDelta(-1, 2)
+	assert.ThatAnyNumber(m, int64(math.MinInt64)).InDelta(uint64(math.MaxUint64), math.MaxUint64+math.MaxInt64+1.0)
+	assert.ThatAnyNumber(m, 0.1).InDelta(0, 0.1).InDelta(uint(1), -0.9)
+	assert.ThatAnyNumber(m, math.Inf(-1)).InDelta(math.Inf(-1), 0)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatAnyNumber(m, uint8(1)).InDelta(200, 5)
+	assert.ThatAnyNumber(m, 1e-300).InDelta(0, 5e-324)
+	assert.ThatAnyNumber(m, math.Inf(1)).InDelta(math.MaxFloat64, math.Inf(1))
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected number to be within ±5 of 200, but it is 1` +
+		`error# Assertion failed: expected number to be within ±5e-324 of 0, but it is 1e-300` +
+		`error# Assertion failed: expected number to be within ±+Inf of 1.7976931348623157e+308, but it is +Inf`)
+
+	// Test expected values must be numbers
+	m.Reset()
+	assert.ThatAnyNumber(m, 1).Equal("1").InDelta(1, nil)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: cannot compare with 1 of non-numeric type string` +
+		`error# Assertion failed: cannot compare with <nil> of non-numeric type <nil>`)
+
+	m.Reset()
+	assert.ThatAnyNumber(m, 5).GreaterOrEqual("x").LessOrEqual([]int{5})
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: cannot compare with x of non-numeric type string` +
+		`error# Assertion failed: cannot compare with [5] of non-numeric type []int`)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"cmp"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// OrderedAssertion encapsulates a value of an ordered type and a test handler
+// for making assertions on its order, such as strings compared lexically or
+// custom types compared by a comparator.
+type OrderedAssertion[T any] struct {
+	AssertionBase[*OrderedAssertion[T]]
+	v       T
+	compare func(a, b T) int
+}
+
+// ThatOrdered returns an OrderedAssertion for the given testing object and value,
+// ordered by its natural order, see cmp.Compare.
+func ThatOrdered[T cmp.Ordered](t internal.TestingT, v T) *OrderedAssertion[T] {
+	return ThatComparable(t, v, cmp.Compare[T])
+}
+
+// ThatComparable returns an OrderedAssertion for the given testing object and
+// value, ordered by compare, which returns a negative number when a < b, a
+// positive number when a > b and zero when a and b are equivalent.
+func ThatComparable[T any](t internal.TestingT, v T, compare func(a, b T) int) *OrderedAssertion[T] {
+	a := &OrderedAssertion[T]{v: v, compare: compare}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// Equal asserts that the value is equivalent to the expected value in the order.
+func (a *OrderedAssertion[T]) Equal(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) != 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.equal", a.pretty(expect), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// NotEqual asserts that the value is not equivalent to the expected value in the order.
+func (a *OrderedAssertion[T]) NotEqual(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) == 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.not_equal", a.pretty(expect)),
+		}, msg...)
+	}
+	return a
+}
+
+// GreaterThan asserts that the value is greater than the expected value.
+func (a *OrderedAssertion[T]) GreaterThan(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) <= 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.greater_than", a.pretty(expect), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// GreaterOrEqual asserts that the value is greater than or equal to the expected value.
+func (a *OrderedAssertion[T]) GreaterOrEqual(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) < 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.greater_or_equal", a.pretty(expect), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// LessThan asserts that the value is less than the expected value.
+func (a *OrderedAssertion[T]) LessThan(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) >= 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.less_than", a.pretty(expect), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// LessOrEqual asserts that the value is less than or equal to the expected value.
+func (a *OrderedAssertion[T]) LessOrEqual(expect T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, expect) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.less_or_equal", a.pretty(expect), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// Between asserts that the value is in the closed range [lower, upper],
+// i.e. both bounds are inclusive.
+func (a *OrderedAssertion[T]) Between(lower, upper T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, lower) < 0 || a.compare(a.v, upper) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.between", a.pretty(lower), a.pretty(upper), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// StrictlyBetween asserts that the value is in the open range (lower, upper),
+// i.e. both bounds are exclusive.
+func (a *OrderedAssertion[T]) StrictlyBetween(lower, upper T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, lower) <= 0 || a.compare(a.v, upper) >= 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.strictly_between", a.pretty(lower), a.pretty(upper), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// InRange asserts that the value is in the half-open range [lower, upper),
+// i.e. the lower bound is inclusive and the upper bound is exclusive.
+func (a *OrderedAssertion[T]) InRange(lower, upper T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, lower) < 0 || a.compare(a.v, upper) >= 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.in_range", a.pretty(lower), a.pretty(upper), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// NotBetween asserts that the value is outside the closed range [lower, upper].
+func (a *OrderedAssertion[T]) NotBetween(lower, upper T, msg ...any) *OrderedAssertion[T] {
+	a.t.Helper()
+	if a.compare(a.v, lower) >= 0 && a.compare(a.v, upper) <= 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("ordered.not_between", a.pretty(lower), a.pretty(upper), a.pretty(a.v)),
+		}, msg...)
+	}
+	return a
+}
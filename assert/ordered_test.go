@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestOrdered(t *testing.T) {
+	m := new(internal.MockTestingT)
+	version := "v1.10"
+
+	m.Reset()
+	assert.ThatOrdered(m, version).Equal("v1.10").NotEqual("v1.9").
+		GreaterThan("v1.0").GreaterOrEqual("v1.10").LessThan("v2").LessOrEqual("v1.10")
+	assert.ThatOrdered(m, 5).Between(5, 10).StrictlyBetween(4, 6).InRange(5, 6).NotBetween(6, 10)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatOrdered(m, version).GreaterThan("v1.9")
+	assert.ThatOrdered(m, version).LessOrEqual("v1.0", "index is 0")
+	assert.ThatOrdered(m, version).Equal("v1.1").NotEqual("v1.10")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: version: expected value to be greater than "v1.9", but it is "v1.10"` +
+		`error# Assertion failed: version: expected value to be less than or equal to "v1.0", but it is "v1.10"
+ message: index is 0` + `error# Assertion failed: version: expected value to be equal to "v1.1", but it is "v1.10"` +
+		`error# Assertion failed: version: expected value not to be equal to "v1.10", but it is`)
+}
+
+func TestOrdered_Bounds(t *testing.T) {
+	m := new(internal.MockTestingT)
+	n := 5
+
+	m.Reset()
+	assert.ThatOrdered(m, n).Between(6, 10)
+	assert.ThatOrdered(m, n).StrictlyBetween(5, 10)
+	assert.ThatOrdered(m, n).InRange(0, 5)
+	assert.ThatOrdered(m, n).NotBetween(0, 5)
+	assert.ThatOrdered(m, n).LessThan(5).GreaterOrEqual(6)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: n: expected value to be in [6, 10], but it is 5` +
+		`error# Assertion failed: n: expected value to be in (5, 10), but it is 5` +
+		`error# Assertion failed: n: expected value to be in [0, 5), but it is 5` +
+		`error# Assertion failed: n: expected value not to be in [0, 5], but it is 5` +
+		`error# Assertion failed: n: expected value to be less than 5, but it is 5` +
+		`error# Assertion failed: n: expected value to be greater than or equal to 6, but it is 5`)
+}
+
+func TestComparable(t *testing.T) {
+	m := new(internal.MockTestingT)
+	deadline := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	compareTime := func(a, b time.Time) int { return a.Compare(b) }
+
+	m.Reset()
+	assert.ThatComparable(m, deadline, compareTime).
+		Equal(deadline.In(time.FixedZone("CST", 8*3600))).
+		Between(deadline.Add(-time.Hour), deadline).
+		InRange(deadline, deadline.Add(time.Second))
+	assert.ThatComparable(m, "Go", strings.Compare).LessThan("go")
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatComparable(m, "Go", func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}).LessThan("go")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected value to be less than "go", but it is "Go"`)
+}
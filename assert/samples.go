@@ -0,0 +1,360 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// DefaultSignificance is the default significance level of the hypothesis
+// tests of SamplesAssertion, i.e. the probability that a test fails although
+// the hypothesis holds. Lower it to make randomized tests less flaky.
+const DefaultSignificance = 0.01
+
+// SamplesAssertion encapsulates numeric samples, such as latencies or random
+// numbers, and a test handler for making statistical assertions on them.
+type SamplesAssertion[T Number] struct {
+	AssertionBase[*SamplesAssertion[T]]
+	v     []T
+	alpha float64
+}
+
+// ThatSamples returns a SamplesAssertion for the given testing object and samples.
+func ThatSamples[T Number](t internal.TestingT, v []T) *SamplesAssertion[T] {
+	a := &SamplesAssertion[T]{v: v, alpha: DefaultSignificance}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// WithSignificance sets the significance level of the following hypothesis
+// tests, DefaultSignificance by default.
+func (a *SamplesAssertion[T]) WithSignificance(alpha float64) *SamplesAssertion[T] {
+	a.alpha = alpha
+	return a
+}
+
+// Mean returns a NumberAssertion for the arithmetic mean of the samples.
+func (a *SamplesAssertion[T]) Mean() *NumberAssertion[float64] {
+	a.t.Helper()
+	if len(a.v) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, len(a.v)),
+		})
+	}
+	mean, _ := meanVariance(a.v)
+	return derivedNumber(&a.AssertionBase, mean, "mean", len(a.v) >= 1)
+}
+
+// Median returns a NumberAssertion for the median of the samples.
+func (a *SamplesAssertion[T]) Median() *NumberAssertion[float64] {
+	a.t.Helper()
+	if len(a.v) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, len(a.v)),
+		})
+	}
+	return derivedNumber(&a.AssertionBase, percentile(a.v, 50), "median", len(a.v) >= 1)
+}
+
+// Percentile returns a NumberAssertion for the p-th percentile of the samples,
+// 0 <= p <= 100, labelled like "latencies.p99". It's interpolated linearly
+// between the closest ranks, like the default method of NumPy.
+func (a *SamplesAssertion[T]) Percentile(p float64) *NumberAssertion[float64] {
+	a.t.Helper()
+	label := "p" + strconv.FormatFloat(p, 'f', -1, 64)
+	if !(p >= 0 && p <= 100) {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.percentile", p),
+		})
+		return derivedNumber(&a.AssertionBase, math.NaN(), label, false)
+	}
+	if len(a.v) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, len(a.v)),
+		})
+	}
+	return derivedNumber(&a.AssertionBase, percentile(a.v, p), label, len(a.v) >= 1)
+}
+
+// StdDev returns a NumberAssertion for the sample standard deviation of the
+// samples, i.e. with Bessel's correction.
+func (a *SamplesAssertion[T]) StdDev() *NumberAssertion[float64] {
+	a.t.Helper()
+	if len(a.v) < 2 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 2, len(a.v)),
+		})
+	}
+	_, variance := meanVariance(a.v)
+	return derivedNumber(&a.AssertionBase, math.Sqrt(variance), "stddev", len(a.v) >= 2)
+}
+
+// Min returns a NumberAssertion for the smallest of the samples.
+func (a *SamplesAssertion[T]) Min() *NumberAssertion[T] {
+	a.t.Helper()
+	var v T
+	if len(a.v) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, len(a.v)),
+		})
+	} else {
+		v = slices.MinFunc(a.v, cmp.Compare[T])
+	}
+	return derivedNumber(&a.AssertionBase, v, "min", len(a.v) >= 1)
+}
+
+// Max returns a NumberAssertion for the largest of the samples.
+func (a *SamplesAssertion[T]) Max() *NumberAssertion[T] {
+	a.t.Helper()
+	var v T
+	if len(a.v) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, len(a.v)),
+		})
+	} else {
+		v = slices.MaxFunc(a.v, cmp.Compare[T])
+	}
+	return derivedNumber(&a.AssertionBase, v, "max", len(a.v) >= 1)
+}
+
+// IsMonotonic asserts that the samples are monotonic, i.e. either
+// non-decreasing or non-increasing. It reports the index where the
+// direction of the samples changes.
+func (a *SamplesAssertion[T]) IsMonotonic(msg ...any) *SamplesAssertion[T] {
+	a.t.Helper()
+	dir := 0
+	for i := 1; i < len(a.v); i++ {
+		c := cmp.Compare(a.v[i], a.v[i-1])
+		if c == 0 {
+			continue
+		}
+		if dir == 0 {
+			dir = c
+			continue
+		}
+		if c != dir {
+			a.Fail(internal.Failure{
+				Summary: a.text("samples.monotonic", i, a.v[i-1], a.v[i]),
+				Actual:  a.pretty(a.v),
+			}, msg...)
+			break
+		}
+	}
+	return a
+}
+
+// UniformDistribution asserts that the samples are uniformly distributed in
+// [lower, upper), by a chi-square goodness-of-fit test over the given number of
+// bins of equal width. The test fails if its p-value is below the significance
+// level. Each bin should expect at least 5 samples for the test to be reliable.
+func (a *SamplesAssertion[T]) UniformDistribution(lower, upper float64, bins int, msg ...any) *SamplesAssertion[T] {
+	a.t.Helper()
+	if bins < 2 || !(lower < upper) || math.IsInf(upper-lower, 0) {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.uniform.invalid", lower, upper, bins),
+		}, msg...)
+		return a
+	}
+	if len(a.v) < bins {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", bins, len(a.v)),
+		}, msg...)
+		return a
+	}
+	counts := make([]int, bins)
+	for i, x := range a.v {
+		f := float64(x)
+		if !(f >= lower && f < upper) {
+			a.Fail(internal.Failure{
+				Summary: a.text("samples.uniform.range", lower, upper, x, i),
+			}, msg...)
+			return a
+		}
+		counts[min(int((f-lower)/(upper-lower)*float64(bins)), bins-1)]++
+	}
+	expected := float64(len(a.v)) / float64(bins)
+	var chi2 float64
+	for _, c := range counts {
+		d := float64(c) - expected
+		chi2 += d * d / expected
+	}
+	if p := chiSquareSurvival(chi2, float64(bins-1)); p < a.alpha {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.uniform"),
+			Details: a.testDetails(chi2, p),
+		}, msg...)
+	}
+	return a
+}
+
+// SameDistributionAs asserts that the samples come from the same distribution
+// as the other samples, by a two-sample Kolmogorov-Smirnov test. The test fails
+// if its p-value is below the significance level.
+func (a *SamplesAssertion[T]) SameDistributionAs(other []T, msg ...any) *SamplesAssertion[T] {
+	a.t.Helper()
+	if len(a.v) < 1 || len(other) < 1 {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.too_few", 1, min(len(a.v), len(other))),
+		}, msg...)
+		return a
+	}
+	x, y := slices.Clone(a.v), slices.Clone(other)
+	slices.SortFunc(x, cmp.Compare[T])
+	slices.SortFunc(y, cmp.Compare[T])
+	var d float64
+	for i, j := 0, 0; i < len(x) && j < len(y); {
+		switch c := cmp.Compare(x[i], y[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			v := x[i]
+			for i < len(x) && x[i] == v {
+				i++
+			}
+			for j < len(y) && y[j] == v {
+				j++
+			}
+		}
+		d = max(d, math.Abs(float64(i)/float64(len(x))-float64(j)/float64(len(y))))
+	}
+	n, m := float64(len(x)), float64(len(y))
+	en := math.Sqrt(n * m / (n + m))
+	if p := kolmogorovSurvival((en + 0.12 + 0.11/en) * d); p < a.alpha {
+		a.Fail(internal.Failure{
+			Summary: a.text("samples.same_distribution"),
+			Details: a.testDetails(d, p),
+		}, msg...)
+	}
+	return a
+}
+
+// testDetails returns the statistic, the p-value and the significance level
+// of a hypothesis test as failure details.
+func (a *SamplesAssertion[T]) testDetails(statistic, p float64) []internal.Detail {
+	return []internal.Detail{
+		{Name: "statistic", Value: strconv.FormatFloat(statistic, 'g', 6, 64)},
+		{Name: "p_value", Value: strconv.FormatFloat(p, 'g', 6, 64)},
+		{Name: "alpha", Value: strconv.FormatFloat(a.alpha, 'g', -1, 64)},
+	}
+}
+
+// meanVariance returns the mean and the sample variance of the samples by
+// Welford's algorithm, NaN if they are not defined.
+func meanVariance[T Number](v []T) (mean, variance float64) {
+	if len(v) == 0 {
+		return math.NaN(), math.NaN()
+	}
+	var m2 float64
+	for i, x := range v {
+		d := float64(x) - mean
+		mean += d / float64(i+1)
+		m2 += d * (float64(x) - mean)
+	}
+	if len(v) < 2 {
+		return mean, math.NaN()
+	}
+	return mean, m2 / float64(len(v)-1)
+}
+
+// percentile returns the p-th percentile of the samples, interpolated
+// linearly between the closest ranks, NaN if there are no samples.
+func percentile[T Number](v []T, p float64) float64 {
+	if len(v) == 0 {
+		return math.NaN()
+	}
+	s := slices.Clone(v)
+	slices.SortFunc(s, cmp.Compare[T])
+	rank := p / 100 * float64(len(s)-1)
+	i := int(rank)
+	if i >= len(s)-1 {
+		return float64(s[len(s)-1])
+	}
+	lo, hi := float64(s[i]), float64(s[i+1])
+	return lo + (rank-float64(i))*(hi-lo)
+}
+
+// chiSquareSurvival returns P(X >= x) for a chi-square distribution with df
+// degrees of freedom, i.e. the regularized upper incomplete gamma Q(df/2, x/2).
+func chiSquareSurvival(x, df float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	a, x := df/2, x/2
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		// series of the lower incomplete gamma P(a, x)
+		sum, term := 1/a, 1/a
+		for n := 1.0; n < 1000; n++ {
+			term *= x / (a + n)
+			sum += term
+			if term < sum*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*math.Exp(-x+a*math.Log(x)-lg)
+	}
+	// continued fraction of the upper incomplete gamma Q(a, x), by Lentz's method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c, d := 1/tiny, 1/b
+	h := d
+	for n := 1.0; n < 1000; n++ {
+		an := -n * (n - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
+
+// kolmogorovSurvival returns P(K > x) for the Kolmogorov distribution,
+// i.e. 2 Σ (-1)^(j-1) exp(-2 j² x²).
+func kolmogorovSurvival(x float64) float64 {
+	if x < 0.2 {
+		return 1
+	}
+	var sum float64
+	sign := 1.0
+	for j := 1.0; j <= 100; j++ {
+		term := sign * math.Exp(-2*j*j*x*x)
+		sum += term
+		if math.Abs(term) < 1e-16 {
+			break
+		}
+		sign = -sign
+	}
+	return min(max(2*sum, 0), 1)
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestSamples_Statistics(t *testing.T) {
+	m := new(internal.MockTestingT)
+	latencies := []int{5, 1, 4, 2, 3, 100}
+
+	m.Reset()
+	assert.ThatSamples(m, latencies).Mean().Equal(115.0 / 6)
+	assert.ThatSamples(m, latencies).Median().Equal(3.5)
+	assert.ThatSamples(m, latencies).Percentile(0).Equal(1)
+	assert.ThatSamples(m, latencies).Percentile(100).Equal(100)
+	assert.ThatSamples(m, latencies).Percentile(90).Equal(52.5)
+	assert.ThatSamples(m, []float64{2, 4, 4, 4, 5, 5, 7, 9}).StdDev().InDelta(2.138089935299395, 1e-12)
+	assert.ThatSamples(m, latencies).Min().Equal(1)
+	assert.ThatSamples(m, latencies).Max().Equal(100)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSamples(m, latencies).Percentile(90).LessThan(50)
+	assert.ThatSamples(m, latencies).Mean().LessThan(10, "too slow")
+	assert.ThatSamples(m, latencies).Max().LessOrEqual(10)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: latencies.p90: expected number to be less than 50, but it is 52.5` +
+		`error# Assertion failed: latencies.mean: expected number to be less than 10, but it is 19.166666666666668
+ message: too slow` +
+		`error# Assertion failed: latencies.max: expected number to be less than or equal to 10, but it is 100`)
+}
+
+func TestSamples_TooFew(t *testing.T) {
+	m := new(internal.MockTestingT)
+	var empty []float64
+
+	m.Reset()
+	assert.ThatSamples(m, empty).Mean().Equal(0)
+	assert.ThatSamples(m, []float64{1}).StdDev().Equal(0)
+	assert.ThatSamples(m, empty).Min().Equal(0)
+	assert.ThatSamples(m, []float64{1}).Percentile(101).Equal(1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: empty: expected at least 1 samples, but got 0` +
+		`error# Assertion failed: expected at least 2 samples, but got 1` +
+		`error# Assertion failed: empty: expected at least 1 samples, but got 0` +
+		`error# Assertion failed: expected percentile to be between 0 and 100, but it is 101`)
+
+	m.Reset()
+	assert.ThatSamples(m, empty).Require().Median().Equal(0)
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: empty: expected at least 1 samples, but got 0`)
+}
+
+func TestSamples_IsMonotonic(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatSamples(m, []int{1, 1, 2, 3, 3}).IsMonotonic()
+	assert.ThatSamples(m, []int{3, 3, 2, 1}).IsMonotonic()
+	assert.ThatSamples(m, []int{}).IsMonotonic()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	counters := []int{1, 2, 2, 1, 3}
+	assert.ThatSamples(m, counters).IsMonotonic()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: counters: expected samples to be monotonic, but the direction changes at index 3, from 2 to 1
+  actual: {1, 2, 2, 1, 3}`)
+}
+
+func TestSamples_UniformDistribution(t *testing.T) {
+	m := new(internal.MockTestingT)
+	r := rand.New(rand.NewPCG(1, 2))
+	uniform := make([]float64, 10000)
+	for i := range uniform {
+		uniform[i] = r.Float64()
+	}
+	skewed := make([]float64, 10000)
+	for i := range skewed {
+		skewed[i] = r.Float64() * r.Float64()
+	}
+
+	m.Reset()
+	assert.ThatSamples(m, uniform).UniformDistribution(0, 1, 20)
+	assert.ThatSamples(m, []int{0, 1, 2, 3, 0, 1, 2, 3}).UniformDistribution(0, 4, 4)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSamples(m, skewed).UniformDistribution(0, 1, 20)
+	assert.ThatSamples(m, uniform).UniformDistribution(0, 0.5, 20)
+	assert.ThatSamples(m, uniform).UniformDistribution(0, 1, 1)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: skewed: expected samples to be uniformly distributed, but the chi-square test rejects it
+    stat: 9662
+ p-value: 0
+   alpha: 0.01` + `error# Assertion failed: uniform: expected samples to be in [0, 0.5), but 0.6764556596678251 at index 0 is not` +
+		`error# Assertion failed: uniform: invalid uniform distribution in [0, 1) with 1 bins`)
+}
+
+func TestSamples_SameDistributionAs(t *testing.T) {
+	m := new(internal.MockTestingT)
+	r := rand.New(rand.NewPCG(3, 4))
+	normal := func(n int, mean float64) []float64 {
+		s := make([]float64, n)
+		for i := range s {
+			s[i] = r.NormFloat64() + mean
+		}
+		return s
+	}
+	before, after, shifted := normal(1000, 0), normal(800, 0), normal(800, 0.3)
+
+	m.Reset()
+	assert.ThatSamples(m, before).SameDistributionAs(after)
+	assert.ThatSamples(m, before).WithSignificance(1e-6).SameDistributionAs(shifted[:50])
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSamples(m, before).SameDistributionAs(shifted)
+	assert.ThatSamples(m, before).SameDistributionAs(nil)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: before: expected samples to have the same distribution, but the Kolmogorov-Smirnov test rejects it
+    stat: 0.114
+ p-value: 1.67604e-05
+   alpha: 0.01` + `error# Assertion failed: before: expected at least 1 samples, but got 0`)
+}
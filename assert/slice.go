@@ -17,8 +17,13 @@
 package assert
 
 import (
+	"cmp"
 	"fmt"
+	"math"
+	"reflect"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/go-spring/gs-assert/internal"
 )
@@ -26,89 +31,94 @@ import (
 // SliceAssertion encapsulates a slice value and a test handler for making assertions on the slice.
 type SliceAssertion[T comparable] struct {
 	AssertionBase[*SliceAssertion[T]]
-	t internal.TestingT
 	v []T
 }
 
 // ThatSlice returns a SliceAssertion for the given testing object and slice value.
 func ThatSlice[T comparable](t internal.TestingT, v []T) *SliceAssertion[T] {
-	return &SliceAssertion[T]{
-		t: t,
-		v: v,
-	}
+	a := &SliceAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
 }
 
 // Length asserts that the slice has the expected length.
-func (a *SliceAssertion[T]) Length(length int, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) Length(length int, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(a.v) != length {
-		str := fmt.Sprintf(`expected slice to have length %d, but it has length %d
-  actual: %v`, length, len(a.v), ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.length", length, len(a.v)),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Nil asserts that the slice is nil.
-func (a *SliceAssertion[T]) Nil(msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) Nil(msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if a.v != nil {
-		str := fmt.Sprintf(`expected slice to be nil, but it is not
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.nil"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotNil asserts that the slice is not nil.
-func (a *SliceAssertion[T]) NotNil(msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NotNil(msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if a.v == nil {
-		str := fmt.Sprintf(`expected slice not to be nil, but it is
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.not_nil"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Empty asserts that the slice is empty.
-func (a *SliceAssertion[T]) Empty(msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) Empty(msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(a.v) != 0 {
-		str := fmt.Sprintf(`expected slice to be empty, but it is not
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.empty"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // NotEmpty asserts that the slice is not empty.
-func (a *SliceAssertion[T]) NotEmpty(msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NotEmpty(msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(a.v) == 0 {
-		str := fmt.Sprintf(`expected slice not to be empty, but it is
-  actual: %v`, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.not_empty"),
+			Actual:  a.json(a.v),
+		}, msg...)
 	}
 	return a
 }
 
 // Equal asserts that the slice is equal to the expected slice.
-func (a *SliceAssertion[T]) Equal(expect []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) Equal(expect []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(a.v) != len(expect) {
-		str := fmt.Sprintf(`expected slices to be equal, but their lengths are different
-  actual: %v
-expected: %v`, ToJsonString(a.v), ToJsonString(expect))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary:  a.text("slice.equal.length"),
+			Actual:   a.json(a.v),
+			Expected: a.json(expect),
+		}, msg...)
 		return a
 	}
 	for i := range a.v {
 		if a.v[i] != expect[i] {
-			str := fmt.Sprintf(`expected slices to be equal, but values at index %d are different
-  actual: %v
-expected: %v`, i, ToJsonString(a.v), ToJsonString(expect))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary:  a.text("slice.equal.index", i),
+				Actual:   a.json(a.v),
+				Expected: a.json(expect),
+			}, msg...)
 			return a
 		}
 	}
@@ -116,7 +126,7 @@ expected: %v`, i, ToJsonString(a.v), ToJsonString(expect))
 }
 
 // NotEqual asserts that the slice is not equal to the expected slice.
-func (a *SliceAssertion[T]) NotEqual(expect []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NotEqual(expect []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(a.v) == len(expect) {
 		equal := true
@@ -127,40 +137,43 @@ func (a *SliceAssertion[T]) NotEqual(expect []T, msg ...string) *SliceAssertion[
 			}
 		}
 		if equal {
-			str := fmt.Sprintf(`expected slices to be different, but they are equal
-  actual: %v`, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.not_equal"),
+				Actual:  a.json(a.v),
+			}, msg...)
 		}
 	}
 	return a
 }
 
 // Contains asserts that the slice contains the expected element.
-func (a *SliceAssertion[T]) Contains(element T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) Contains(element T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if slices.Contains(a.v, element) {
 		return a
 	}
-	str := fmt.Sprintf(`expected slice to contain element %s, but it is missing
-  actual: %v`, ToPrettyString(element), ToJsonString(a.v))
-	internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	a.Fail(internal.Failure{
+		Summary: a.text("slice.contains", a.pretty(element)),
+		Actual:  a.json(a.v),
+	}, msg...)
 	return a
 }
 
 // NotContains asserts that the slice does not contain the expected element.
-func (a *SliceAssertion[T]) NotContains(element T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NotContains(element T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if slices.Contains(a.v, element) {
-		str := fmt.Sprintf(`expected slice not to contain element %+v, but it is found
-  actual: %v`, element, ToJsonString(a.v))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.not_contains", element),
+			Actual:  a.json(a.v),
+		}, msg...)
 		return a
 	}
 	return a
 }
 
 // ContainsSlice asserts that the slice contains the expected sub-slice.
-func (a *SliceAssertion[T]) ContainsSlice(sub []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) ContainsSlice(sub []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(sub) == 0 {
 		return a
@@ -177,15 +190,18 @@ func (a *SliceAssertion[T]) ContainsSlice(sub []T, msg ...string) *SliceAssertio
 			return a
 		}
 	}
-	str := fmt.Sprintf(`expected slice to contain sub-slice, but it is not
-  actual: %v
-     sub: %v`, ToJsonString(a.v), ToJsonString(sub))
-	internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	a.Fail(internal.Failure{
+		Summary: a.text("slice.contains_slice"),
+		Actual:  a.json(a.v),
+		Details: []internal.Detail{
+			{Name: "sub", Value: a.json(sub)},
+		},
+	}, msg...)
 	return a
 }
 
 // NotContainsSlice asserts that the slice does not contain the expected sub-slice.
-func (a *SliceAssertion[T]) NotContainsSlice(sub []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NotContainsSlice(sub []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(sub) == 0 {
 		return a
@@ -199,32 +215,201 @@ func (a *SliceAssertion[T]) NotContainsSlice(sub []T, msg ...string) *SliceAsser
 			}
 		}
 		if match {
-			str := fmt.Sprintf(`expected slice not to contain sub-slice, but it is
-  actual: %v
-     sub: %v`, ToJsonString(a.v), ToJsonString(sub))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.not_contains_slice"),
+				Actual:  a.json(a.v),
+				Details: []internal.Detail{
+					{Name: "sub", Value: a.json(sub)},
+				},
+			}, msg...)
+			return a
+		}
+	}
+	return a
+}
+
+// ContainsSubsequence asserts that the slice contains the elements of sub in
+// the same order, with any elements between them. The failure tells which
+// element of sub is missing after the elements matched so far.
+func (a *SliceAssertion[T]) ContainsSubsequence(sub []T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	if s := a.checkSubsequence(sub); s != "" {
+		a.Fail(internal.Failure{
+			Summary: s,
+			Actual:  a.json(a.v),
+			Details: []internal.Detail{
+				{Name: "sub", Value: a.json(sub)},
+			},
+		}, msg...)
+	}
+	return a
+}
+
+// ContainsInOrder asserts that the slice contains the elements in the given
+// order, with any elements between them, like ContainsSubsequence.
+func (a *SliceAssertion[T]) ContainsInOrder(elements ...T) *SliceAssertion[T] {
+	a.t.Helper()
+	return a.ContainsSubsequence(elements)
+}
+
+// checkSubsequence returns the failure summary if the slice doesn't contain
+// sub as a subsequence, "" otherwise.
+func (a *SliceAssertion[T]) checkSubsequence(sub []T) string {
+	i, last := 0, -1
+	for j := 0; i < len(sub) && j < len(a.v); j++ {
+		if a.v[j] == sub[i] {
+			i, last = i+1, j
+		}
+	}
+	switch {
+	case i == len(sub):
+		return ""
+	case i == 0:
+		return a.text("slice.subsequence.first", a.pretty(sub[0]))
+	default:
+		return a.text("slice.subsequence", i, a.pretty(sub[i]), i-1, last)
+	}
+}
+
+// IndexOf returns a NumberAssertion for the index of the first occurrence of
+// the element in the slice, -1 if it's missing, labelled like "s.index_of".
+func (a *SliceAssertion[T]) IndexOf(element T) *NumberAssertion[int] {
+	return derivedNumber(&a.AssertionBase, slices.Index(a.v, element), "index_of", true)
+}
+
+// CountOf returns a NumberAssertion for the number of occurrences of the
+// element in the slice, labelled like "s.count_of".
+func (a *SliceAssertion[T]) CountOf(element T) *NumberAssertion[int] {
+	n := 0
+	for _, v := range a.v {
+		if v == element {
+			n++
+		}
+	}
+	return derivedNumber(&a.AssertionBase, n, "count_of", true)
+}
+
+// AppearsBefore asserts that the first occurrence of the element x is before
+// the first occurrence of the element y in the slice.
+func (a *SliceAssertion[T]) AppearsBefore(x, y T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	var s string
+	i, j := slices.Index(a.v, x), slices.Index(a.v, y)
+	switch {
+	case i < 0:
+		s = a.text("slice.appears_before.missing", a.pretty(x), a.pretty(y), a.pretty(x))
+	case j < 0:
+		s = a.text("slice.appears_before.missing", a.pretty(x), a.pretty(y), a.pretty(y))
+	case i >= j:
+		s = a.text("slice.appears_before", a.pretty(x), a.pretty(y), i, j)
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s, Actual: a.json(a.v)}, msg...)
+	}
+	return a
+}
+
+// ContainsAll asserts that the slice contains all the elements, in any order.
+// The failure lists the missing elements.
+func (a *SliceAssertion[T]) ContainsAll(elements []T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	var missing []T
+	for _, e := range elements {
+		if !slices.Contains(a.v, e) && !slices.Contains(missing, e) {
+			missing = append(missing, e)
+		}
+	}
+	if len(missing) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.contains_all", a.json(missing)),
+			Actual:  a.json(a.v),
+			Details: []internal.Detail{
+				{Name: "elements", Value: a.json(elements)},
+			},
+		}, msg...)
+	}
+	return a
+}
+
+// ContainsAny asserts that the slice contains at least one of the elements.
+func (a *SliceAssertion[T]) ContainsAny(elements []T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	for _, e := range elements {
+		if slices.Contains(a.v, e) {
 			return a
 		}
 	}
+	a.Fail(internal.Failure{
+		Summary: a.text("slice.contains_any"),
+		Actual:  a.json(a.v),
+		Details: []internal.Detail{
+			{Name: "elements", Value: a.json(elements)},
+		},
+	}, msg...)
+	return a
+}
+
+// ContainsNone asserts that the slice contains none of the elements.
+// The failure tells the first element of the slice that is one of them.
+func (a *SliceAssertion[T]) ContainsNone(elements []T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	for i, v := range a.v {
+		if slices.Contains(elements, v) {
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.contains_none", a.pretty(v), i),
+				Actual:  a.json(a.v),
+				Details: []internal.Detail{
+					{Name: "elements", Value: a.json(elements)},
+				},
+			}, msg...)
+			break
+		}
+	}
+	return a
+}
+
+// ContainsOnly asserts that each element of the slice is one of the elements,
+// in any order and with duplicates allowed. The failure tells the first element
+// of the slice that is not one of them.
+func (a *SliceAssertion[T]) ContainsOnly(elements []T, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	for i, v := range a.v {
+		if !slices.Contains(elements, v) {
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.contains_only", a.pretty(v), i),
+				Actual:  a.json(a.v),
+				Details: []internal.Detail{
+					{Name: "elements", Value: a.json(elements)},
+				},
+			}, msg...)
+			break
+		}
+	}
 	return a
 }
 
 // HasPrefix asserts that the slice starts with the specified prefix.
-func (a *SliceAssertion[T]) HasPrefix(prefix []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) HasPrefix(prefix []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(prefix) > len(a.v) {
-		str := fmt.Sprintf(`expected slice to start with prefix, but it is not
-  actual: %v
-  prefix: %v`, ToJsonString(a.v), ToJsonString(prefix))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.has_prefix"),
+			Actual:  a.json(a.v),
+			Details: []internal.Detail{
+				{Name: "prefix", Value: a.json(prefix)},
+			},
+		}, msg...)
 		return a
 	}
 	for i := range prefix {
 		if a.v[i] != prefix[i] {
-			str := fmt.Sprintf(`expected slice to start with prefix, but it is not
-  actual: %v
-  prefix: %v`, ToJsonString(a.v), ToJsonString(prefix))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.has_prefix"),
+				Actual:  a.json(a.v),
+				Details: []internal.Detail{
+					{Name: "prefix", Value: a.json(prefix)},
+				},
+			}, msg...)
 			return a
 		}
 	}
@@ -232,52 +417,127 @@ func (a *SliceAssertion[T]) HasPrefix(prefix []T, msg ...string) *SliceAssertion
 }
 
 // HasSuffix asserts that the slice ends with the specified suffix.
-func (a *SliceAssertion[T]) HasSuffix(suffix []T, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) HasSuffix(suffix []T, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if len(suffix) > len(a.v) {
-		str := fmt.Sprintf(`expected slice to end with suffix, but it is not
-  actual: %v
-  suffix: %v`, ToJsonString(a.v), ToJsonString(suffix))
-		internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.has_suffix"),
+			Actual:  a.json(a.v),
+			Details: []internal.Detail{
+				{Name: "suffix", Value: a.json(suffix)},
+			},
+		}, msg...)
 		return a
 	}
 	offset := len(a.v) - len(suffix)
 	for i := range suffix {
 		if a.v[offset+i] != suffix[i] {
-			str := fmt.Sprintf(`expected slice to end with suffix, but it is not
-  actual: %v
-  suffix: %v`, ToJsonString(a.v), ToJsonString(suffix))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.has_suffix"),
+				Actual:  a.json(a.v),
+				Details: []internal.Detail{
+					{Name: "suffix", Value: a.json(suffix)},
+				},
+			}, msg...)
 			return a
 		}
 	}
 	return a
 }
 
-// AllUnique asserts that all elements in the slice are unique.
-func (a *SliceAssertion[T]) AllUnique(msg ...string) *SliceAssertion[T] {
+// AllUnique asserts that all elements in the slice are unique. The failure
+// lists each duplicated element with all of its indices.
+func (a *SliceAssertion[T]) AllUnique(msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
-	seen := make(map[T]bool)
+	if dups := duplicates(a.v, func(v T) T { return v }); len(dups) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.all_unique"),
+			Actual:  a.json(a.v),
+			Details: duplicateDetails(&a.AssertionBase, dups),
+		}, msg...)
+	}
+	return a
+}
+
+// UniqueBy asserts that the keys of all elements in the slice are unique,
+// such as the IDs of users. The failure lists each duplicated key with the
+// indices of all of its elements.
+func UniqueBy[T, K comparable](a *SliceAssertion[T], key func(T) K, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	if dups := duplicates(a.v, key); len(dups) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.unique_by"),
+			Actual:  a.json(a.v),
+			Details: duplicateDetails(&a.AssertionBase, dups),
+		}, msg...)
+	}
+	return a
+}
+
+// GroupBy returns a MapAssertion for the sizes of the groups of elements in
+// the slice with the same key, labelled like "s.group_by", e.g. to assert the
+// distribution of the elements in one chain.
+func GroupBy[T, K comparable](a *SliceAssertion[T], key func(T) K) *MapAssertion[K, int] {
+	sizes := make(map[K]int)
 	for _, v := range a.v {
-		if seen[v] {
-			str := fmt.Sprintf(`expected all elements in the slice to be unique, but duplicate element %+v is found
-  actual: %v`, v, ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
-			return a
+		sizes[key(v)]++
+	}
+	m := &MapAssertion[K, int]{v: sizes}
+	m.AssertionBase = nested(&a.AssertionBase, m, "group_by")
+	return m
+}
+
+// duplicate is a key shared by several elements of a slice.
+type duplicate[K comparable] struct {
+	key     K
+	indices []int
+}
+
+// duplicates returns the keys shared by several elements of the slice, in
+// the order of their first elements.
+func duplicates[T any, K comparable](v []T, key func(T) K) []duplicate[K] {
+	indices := make(map[K][]int)
+	var keys []K
+	for i, e := range v {
+		k := key(e)
+		if _, ok := indices[k]; !ok {
+			keys = append(keys, k)
 		}
-		seen[v] = true
+		indices[k] = append(indices[k], i)
 	}
-	return a
+	var dups []duplicate[K]
+	for _, k := range keys {
+		if len(indices[k]) > 1 {
+			dups = append(dups, duplicate[K]{key: k, indices: indices[k]})
+		}
+	}
+	return dups
+}
+
+// duplicateDetails returns the duplicated keys as failure details, named by
+// the indices of their elements. At most maxMismatches keys are listed.
+func duplicateDetails[P any, K comparable](a *AssertionBase[P], dups []duplicate[K]) []internal.Detail {
+	var details []internal.Detail
+	for i, d := range dups {
+		if i == maxMismatches {
+			details = append(details, internal.Detail{Name: "...", Value: a.text("slice.duplicates.more", len(dups)-i)})
+			break
+		}
+		name := strings.ReplaceAll(fmt.Sprint(d.indices), " ", ", ")
+		details = append(details, internal.Detail{Name: name, Value: a.pretty(d.key)})
+	}
+	return details
 }
 
 // AllMatches asserts that all elements in the slice satisfy the given condition.
-func (a *SliceAssertion[T]) AllMatches(fn func(T) bool, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) AllMatches(fn func(T) bool, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	for _, v := range a.v {
 		if !fn(v) {
-			str := fmt.Sprintf(`expected all elements in the slice to satisfy the condition, but element %s does not
-  actual: %v`, ToPrettyString(v), ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.all_match", a.pretty(v)),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
@@ -285,27 +545,158 @@ func (a *SliceAssertion[T]) AllMatches(fn func(T) bool, msg ...string) *SliceAss
 }
 
 // AnyMatches asserts that at least one element in the slice satisfies the given condition.
-func (a *SliceAssertion[T]) AnyMatches(fn func(T) bool, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) AnyMatches(fn func(T) bool, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	if slices.ContainsFunc(a.v, fn) {
 		return a
 	}
-	str := fmt.Sprintf(`expected at least one element in the slice to satisfy the condition, but none do
-  actual: %v`, ToJsonString(a.v))
-	internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+	a.Fail(internal.Failure{
+		Summary: a.text("slice.any_match"),
+		Actual:  a.json(a.v),
+	}, msg...)
 	return a
 }
 
 // NoneMatches asserts that no element in the slice satisfies the given condition.
-func (a *SliceAssertion[T]) NoneMatches(fn func(T) bool, msg ...string) *SliceAssertion[T] {
+func (a *SliceAssertion[T]) NoneMatches(fn func(T) bool, msg ...any) *SliceAssertion[T] {
 	a.t.Helper()
 	for _, v := range a.v {
 		if fn(v) {
-			str := fmt.Sprintf(`expected no element in the slice to satisfy the condition, but element %s does
-  actual: %v`, ToPrettyString(v), ToJsonString(a.v))
-			internal.Fail(a.t, a.fatalOnFailure, str, msg...)
+			a.Fail(internal.Failure{
+				Summary: a.text("slice.none_match", a.pretty(v)),
+				Actual:  a.json(a.v),
+			}, msg...)
 			return a
 		}
 	}
 	return a
 }
+
+// Element returns an Assertion for the element of the slice at the index,
+// labelled like "items[3]". If the index is out of range, it fails, and the
+// returned assertion only records its failures.
+func (a *SliceAssertion[T]) Element(index int) *Assertion {
+	a.t.Helper()
+	var v any
+	ok := index >= 0 && index < len(a.v)
+	if ok {
+		v = a.v[index]
+	} else {
+		a.Fail(internal.Failure{
+			Summary: a.text("slice.element", index, len(a.v)),
+			Actual:  a.json(a.v),
+		})
+	}
+	n := &Assertion{v: v}
+	n.AssertionBase = nested(&a.AssertionBase, n, "["+strconv.Itoa(index)+"]")
+	n.checkOnly = n.checkOnly || !ok
+	return n
+}
+
+// IsSorted asserts that the slice is sorted in ascending order, i.e. each
+// element is less than or equal to the next one. The elements must be of an
+// ordered kind, like integers, floats or strings; use IsSortedBy otherwise.
+// NaN elements are unordered, so a slice containing NaN is never sorted.
+func (a *SliceAssertion[T]) IsSorted(msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	s := a.checkOrdered()
+	if s == "" {
+		s = a.checkSorted(naturalCompare[T](), "slice.sorted", func(c int) bool { return c <= 0 })
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s, Actual: a.json(a.v)}, msg...)
+	}
+	return a
+}
+
+// IsSortedBy asserts that the slice is sorted in ascending order by compare,
+// which returns a negative number when a < b, a positive number when a > b
+// and zero when a and b are equivalent.
+func (a *SliceAssertion[T]) IsSortedBy(compare func(a, b T) int, msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	if s := a.checkSorted(compare, "slice.sorted", func(c int) bool { return c <= 0 }); s != "" {
+		a.Fail(internal.Failure{Summary: s, Actual: a.json(a.v)}, msg...)
+	}
+	return a
+}
+
+// IsStrictlySorted asserts that the slice is sorted in ascending order without
+// duplicates, i.e. each element is less than the next one. The elements must be
+// of an ordered kind, like integers, floats or strings.
+func (a *SliceAssertion[T]) IsStrictlySorted(msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	s := a.checkOrdered()
+	if s == "" {
+		s = a.checkSorted(naturalCompare[T](), "slice.strictly_sorted", func(c int) bool { return c < 0 })
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s, Actual: a.json(a.v)}, msg...)
+	}
+	return a
+}
+
+// IsSortedDesc asserts that the slice is sorted in descending order, i.e. each
+// element is greater than or equal to the next one. The elements must be of an
+// ordered kind, like integers, floats or strings.
+func (a *SliceAssertion[T]) IsSortedDesc(msg ...any) *SliceAssertion[T] {
+	a.t.Helper()
+	s := a.checkOrdered()
+	if s == "" {
+		s = a.checkSorted(naturalCompare[T](), "slice.sorted_desc", func(c int) bool { return c >= 0 })
+	}
+	if s != "" {
+		a.Fail(internal.Failure{Summary: s, Actual: a.json(a.v)}, msg...)
+	}
+	return a
+}
+
+// checkOrdered returns the failure summary if the elements of the slice are
+// not of an ordered kind, or if any of them is NaN, which is unordered,
+// "" otherwise.
+func (a *SliceAssertion[T]) checkOrdered() string {
+	if naturalCompare[T]() == nil {
+		return a.text("slice.not_ordered", reflect.TypeFor[T]().String())
+	}
+	for i, e := range a.v {
+		if v := reflect.ValueOf(e); v.CanFloat() && math.IsNaN(v.Float()) {
+			return a.text("slice.nan", i)
+		}
+	}
+	return ""
+}
+
+// checkSorted returns the failure summary with the first pair of adjacent
+// elements whose comparison doesn't satisfy inOrder, "" if there is none.
+func (a *SliceAssertion[T]) checkSorted(compare func(a, b T) int, key string, inOrder func(c int) bool) string {
+	for i := 1; i < len(a.v); i++ {
+		if !inOrder(compare(a.v[i-1], a.v[i])) {
+			return a.text(key, i-1, a.pretty(a.v[i-1]), i, a.pretty(a.v[i]))
+		}
+	}
+	return ""
+}
+
+// naturalCompare returns the function comparing values of T by the natural
+// order of their kind, like cmp.Compare, nil if the kind is not ordered.
+func naturalCompare[T any]() func(a, b T) int {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		}
+	case reflect.String:
+		return func(a, b T) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}
+	default:
+		return nil
+	}
+}
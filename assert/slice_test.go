@@ -17,6 +17,7 @@
 package assert_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/go-spring/gs-assert/assert"
@@ -42,7 +43,7 @@ func TestSlice_Length(t *testing.T) {
 	assert.ThatSlice(m, []float64{1.1}).Require().Length(0, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to have length 0, but it has length 1
   actual: [1.1]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -65,7 +66,7 @@ func TestSlice_Length(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).Length(5, "should have length 5")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to have length 5, but it has length 3
   actual: [1,2,3]
- message: "should have length 5"`)
+ message: should have length 5`)
 
 	// Test Require mode success (no output)
 	m.Reset()
@@ -92,7 +93,7 @@ func TestSlice_Nil(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2}).Require().Nil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to be nil, but it is not
   actual: [1,2]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice (not nil) case
 	m.Reset()
@@ -130,7 +131,7 @@ func TestSlice_NotNil(t *testing.T) {
 	assert.ThatSlice(m, []int(nil)).Require().NotNil("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice not to be nil, but it is
   actual: null
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice (non-nil) case
 	m.Reset()
@@ -142,7 +143,7 @@ func TestSlice_NotNil(t *testing.T) {
 	assert.ThatSlice(m, []int(nil)).NotNil("should not be nil")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice not to be nil, but it is
   actual: null
- message: "should not be nil"`)
+ message: should not be nil`)
 
 	// Test Require mode success
 	m.Reset()
@@ -169,7 +170,7 @@ func TestSlice_Empty(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2}).Require().Empty("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to be empty, but it is not
   actual: [1,2]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test nil slice (should also be considered empty)
 	m.Reset()
@@ -187,7 +188,7 @@ func TestSlice_Empty(t *testing.T) {
 	assert.ThatSlice(m, []int{1}).Empty("should be empty")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to be empty, but it is not
   actual: [1]
- message: "should be empty"`)
+ message: should be empty`)
 
 	// Test Require mode success
 	m.Reset()
@@ -214,7 +215,7 @@ func TestSlice_NotEmpty(t *testing.T) {
 	assert.ThatSlice(m, []string(nil)).Require().NotEmpty("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice not to be empty, but it is
   actual: null
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test multi-element slice
 	m.Reset()
@@ -232,7 +233,7 @@ func TestSlice_NotEmpty(t *testing.T) {
 	assert.ThatSlice(m, []string{}).NotEmpty("should not be empty")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice not to be empty, but it is
   actual: []
- message: "should not be empty"`)
+ message: should not be empty`)
 
 	// Test Require mode success
 	m.Reset()
@@ -261,7 +262,7 @@ expected: [4,5]`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slices to be equal, but values at index 2 are different
   actual: [1,2,3]
 expected: [1,2,4]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slices equality
 	m.Reset()
@@ -300,7 +301,7 @@ expected: ["a","x","c"]`)
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slices to be equal, but values at index 1 are different
   actual: [1,2]
 expected: [1,3]
- message: "should be equal"`)
+ message: should be equal`)
 
 	// Test Require mode length failure
 	m.Reset()
@@ -329,7 +330,7 @@ func TestSlice_NotEqual(t *testing.T) {
 	assert.ThatSlice(m, []string{"a", "b"}).Require().NotEqual([]string{"a", "b"}, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slices to be different, but they are equal
   actual: ["a","b"]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice vs non-empty slice
 	m.Reset()
@@ -362,7 +363,7 @@ func TestSlice_NotEqual(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).NotEqual([]int{1, 2, 3}, "should be different")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slices to be different, but they are equal
   actual: [1,2,3]
- message: "should be different"`)
+ message: should be different`)
 
 	// Test Require mode success
 	m.Reset()
@@ -389,7 +390,7 @@ func TestSlice_Contains(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).Require().Contains(4, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to contain element 4, but it is missing
   actual: [1,2,3]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -428,7 +429,7 @@ func TestSlice_Contains(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2}).Contains(3, "should contain 3")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to contain element 3, but it is missing
   actual: [1,2]
- message: "should contain 3"`)
+ message: should contain 3`)
 
 	// Test Require mode success
 	m.Reset()
@@ -455,7 +456,7 @@ func TestSlice_NotContains(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).Require().NotContains(2, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice not to contain element 2, but it is found
   actual: [1,2,3]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -493,7 +494,7 @@ func TestSlice_NotContains(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2}).NotContains(2, "should not contain 2")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice not to contain element 2, but it is found
   actual: [1,2]
- message: "should not contain 2"`)
+ message: should not contain 2`)
 
 	// Test Require mode success
 	m.Reset()
@@ -527,7 +528,7 @@ func TestSlice_ContainsSlice(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to contain sub-slice, but it is not
   actual: [1,2,3,4]
      sub: [2,4]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice contains empty sub-slice
 	m.Reset()
@@ -571,7 +572,7 @@ func TestSlice_ContainsSlice(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to contain sub-slice, but it is not
   actual: ["a","b"]
      sub: ["c"]
- message: "should contain c"`)
+ message: should contain c`)
 
 	// Test Require mode success
 	m.Reset()
@@ -605,7 +606,7 @@ func TestSlice_NotContainsSlice(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice not to contain sub-slice, but it is
   actual: [1,2,3,4]
      sub: [2,3]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice not containing non-empty sub-slice
 	m.Reset()
@@ -638,7 +639,7 @@ func TestSlice_NotContainsSlice(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice not to contain sub-slice, but it is
   actual: ["a","b","c"]
      sub: ["b","c"]
- message: "should not contain bc"`)
+ message: should not contain bc`)
 
 	// Test Require mode success
 	m.Reset()
@@ -667,7 +668,7 @@ func TestSlice_HasPrefix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to start with prefix, but it is not
   actual: [1,2,3]
   prefix: [2,3]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty prefix
 	m.Reset()
@@ -713,7 +714,7 @@ func TestSlice_HasPrefix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to start with prefix, but it is not
   actual: [1,2]
   prefix: [2,3]
- message: "should start with 2,3"`)
+ message: should start with 2,3`)
 
 	// Test Require mode success
 	m.Reset()
@@ -742,7 +743,7 @@ func TestSlice_HasSuffix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected slice to end with suffix, but it is not
   actual: [1,2,3]
   suffix: [1,2]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty suffix
 	m.Reset()
@@ -788,7 +789,7 @@ func TestSlice_HasSuffix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice to end with suffix, but it is not
   actual: [1,2]
   suffix: [1,3]
- message: "should end with 1,3"`)
+ message: should end with 1,3`)
 
 	// Test Require mode success
 	m.Reset()
@@ -807,15 +808,17 @@ func TestSlice_AllUnique(t *testing.T) {
 	// Test failure case with duplicate elements
 	m.Reset()
 	assert.ThatSlice(m, []int{1, 2, 1}).AllUnique()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicate element 1 is found
-  actual: [1,2,1]`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
+  actual: [1,2,1]
+  [0, 2]: 1`)
 
 	// Test failure with Require mode
 	m.Reset()
 	assert.ThatSlice(m, []int{1, 2, 1}).Require().AllUnique("index is 0")
-	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected all elements in the slice to be unique, but duplicate element 1 is found
+	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
   actual: [1,2,1]
- message: "index is 0"`)
+  [0, 2]: 1
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -835,27 +838,31 @@ func TestSlice_AllUnique(t *testing.T) {
 	// Test two identical elements
 	m.Reset()
 	assert.ThatSlice(m, []int{5, 5}).AllUnique()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicate element 5 is found
-  actual: [5,5]`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
+  actual: [5,5]
+  [0, 1]: 5`)
 
 	// Test first and last elements are the same
 	m.Reset()
 	assert.ThatSlice(m, []int{1, 2, 3, 1}).AllUnique()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicate element 1 is found
-  actual: [1,2,3,1]`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
+  actual: [1,2,3,1]
+  [0, 3]: 1`)
 
 	// Test boolean type with duplicates
 	m.Reset()
 	assert.ThatSlice(m, []bool{true, false, true}).AllUnique()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicate element true is found
-  actual: [true,false,true]`)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
+  actual: [true,false,true]
+  [0, 2]: true`)
 
 	// Test with custom message
 	m.Reset()
 	assert.ThatSlice(m, []int{1, 2, 1}).AllUnique("all elements should be unique")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicate element 1 is found
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to be unique, but duplicates are found
   actual: [1,2,1]
- message: "all elements should be unique"`)
+  [0, 2]: 1
+ message: all elements should be unique`)
 
 	// Test Require mode success
 	m.Reset()
@@ -882,7 +889,7 @@ func TestSlice_AllMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{2, 3, 4, 6}).Require().AllMatches(func(n int) bool { return n%2 == 0 }, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected all elements in the slice to satisfy the condition, but element 3 does not
   actual: [2,3,4,6]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -922,7 +929,7 @@ func TestSlice_AllMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).AllMatches(func(n int) bool { return n%2 == 0 }, "all elements should be even")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected all elements in the slice to satisfy the condition, but element 1 does not
   actual: [1,2,3]
- message: "all elements should be even"`)
+ message: all elements should be even`)
 
 	// Test Require mode success
 	m.Reset()
@@ -949,7 +956,7 @@ func TestSlice_AnyMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 3, 5, 7}).Require().AnyMatches(func(n int) bool { return n%2 == 0 }, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected at least one element in the slice to satisfy the condition, but none do
   actual: [1,3,5,7]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice
 	m.Reset()
@@ -984,7 +991,7 @@ func TestSlice_AnyMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 3, 5}).AnyMatches(func(n int) bool { return n%2 == 0 }, "should have at least one even number")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected at least one element in the slice to satisfy the condition, but none do
   actual: [1,3,5]
- message: "should have at least one even number"`)
+ message: should have at least one even number`)
 
 	// Test Require mode success
 	m.Reset()
@@ -1008,7 +1015,7 @@ func TestSlice_NoneMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3, 5}).Require().NoneMatches(func(n int) bool { return n%2 == 0 }, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected no element in the slice to satisfy the condition, but element 2 does
   actual: [1,2,3,5]
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test empty slice case
 	m.Reset()
@@ -1036,10 +1043,223 @@ func TestSlice_NoneMatches(t *testing.T) {
 	assert.ThatSlice(m, []int{1, 2, 3}).NoneMatches(func(n int) bool { return n%2 == 0 }, "should not contain even numbers")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected no element in the slice to satisfy the condition, but element 2 does
   actual: [1,2,3]
- message: "should not contain even numbers"`)
+ message: should not contain even numbers`)
 
 	// Test complex type
 	m.Reset()
 	assert.ThatSlice(m, []struct{ A, B int }{{1, 3}, {3, 5}}).NoneMatches(func(s struct{ A, B int }) bool { return s.A%2 == 0 })
 	assert.ThatString(t, m.String()).Equal("")
 }
+
+func TestSlice_IsSorted(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	assert.ThatSlice(m, []int{1, 2, 2, 3}).IsSorted()
+	assert.ThatSlice(m, []string{"a", "b", "c"}).IsSorted().IsStrictlySorted()
+	assert.ThatSlice(m, []float64{3, 2.5, 2.5}).IsSortedDesc()
+	assert.ThatSlice(m, []int{}).IsSorted().IsStrictlySorted().IsSortedDesc()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	ids := []int{1, 3, 2, 4}
+	assert.ThatSlice(m, ids).IsSorted()
+	assert.ThatSlice(m, []string{"a", "b", "b"}).IsStrictlySorted()
+	assert.ThatSlice(m, []uint{3, 1, 2}).IsSortedDesc("index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: ids: expected slice to be sorted, but element 1 (3) is greater than element 2 (2)
+  actual: [1,3,2,4]` + `error# Assertion failed: expected slice to be strictly sorted, but element 1 ("b") is not less than element 2 ("b")
+  actual: ["a","b","b"]` + `error# Assertion failed: expected slice to be sorted in descending order, but element 1 (1) is less than element 2 (2)
+  actual: [3,1,2]
+ message: index is 0`)
+
+	m.Reset()
+	type point struct{ X, Y int }
+	assert.ThatSlice(m, []point{{1, 2}}).IsSorted()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected slice elements to be ordered, but type assert_test.point is not, use IsSortedBy
+  actual: [{"X":1,"Y":2}]`)
+
+	// Test NaN elements are unordered
+	m.Reset()
+	values := []float64{1, math.NaN(), 0}
+	assert.ThatSlice(m, values).IsSorted()
+	assert.ThatSlice(m, []float32{float32(math.NaN())}).IsStrictlySorted()
+	assert.ThatSlice(m, []float64{2, 1, math.NaN()}).IsSortedDesc()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: values: expected slice elements to be ordered, but element 1 is NaN, which is unordered
+  actual: {1, NaN, 0}` + `error# Assertion failed: expected slice elements to be ordered, but element 0 is NaN, which is unordered
+  actual: {NaN}` + `error# Assertion failed: expected slice elements to be ordered, but element 2 is NaN, which is unordered
+  actual: {2, 1, NaN}`)
+}
+
+func TestSlice_IsSortedBy(t *testing.T) {
+	m := new(internal.MockTestingT)
+	type user struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b user) int { return a.Age - b.Age }
+
+	m.Reset()
+	assert.ThatSlice(m, []user{{"bob", 20}, {"alice", 20}, {"tom", 30}}).IsSortedBy(byAge)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	users := []user{{"bob", 30}, {"alice", 20}}
+	assert.ThatSlice(m, users).IsSortedBy(byAge)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: users: expected slice to be sorted, but element 0 ({Name:"bob", Age:30}) is greater than element 1 ({Name:"alice", Age:20})
+  actual: [{"Name":"bob","Age":30},{"Name":"alice","Age":20}]`)
+}
+
+func TestSlice_ContainsSubsequence(t *testing.T) {
+	m := new(internal.MockTestingT)
+	events := []string{"open", "read", "read", "write", "close"}
+
+	m.Reset()
+	assert.ThatSlice(m, events).ContainsSubsequence([]string{"open", "write", "close"})
+	assert.ThatSlice(m, events).ContainsSubsequence(nil).ContainsInOrder("read", "read", "close")
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, events).ContainsSubsequence([]string{"open", "write", "read"}, "index is 0")
+	assert.ThatSlice(m, events).ContainsInOrder("seek", "close")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: events: expected slice to contain the subsequence, but its element 2 ("read") is missing after its element 1 matched at index 3
+  actual: ["open","read","read","write","close"]
+     sub: ["open","write","read"]
+ message: index is 0` + `error# Assertion failed: events: expected slice to contain the subsequence, but its element 0 ("seek") is missing
+  actual: ["open","read","read","write","close"]
+     sub: ["seek","close"]`)
+}
+
+func TestSlice_IndexOf(t *testing.T) {
+	m := new(internal.MockTestingT)
+	events := []string{"open", "read", "read", "close"}
+
+	m.Reset()
+	assert.ThatSlice(m, events).IndexOf("read").Equal(1)
+	assert.ThatSlice(m, events).IndexOf("seek").Equal(-1)
+	assert.ThatSlice(m, events).AppearsBefore("open", "read").AppearsBefore("read", "close")
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, events).IndexOf("close").LessThan(2)
+	assert.ThatSlice(m, events).AppearsBefore("close", "read")
+	assert.ThatSlice(m, events).AppearsBefore("seek", "read")
+	assert.ThatSlice(m, events).AppearsBefore("open", "open")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: events.index_of: expected number to be less than 2, but it is 3` +
+		`error# Assertion failed: events: expected "close" to appear before "read", but they are at index 3 and 1
+  actual: ["open","read","read","close"]` + `error# Assertion failed: events: expected "seek" to appear before "read", but "seek" is missing
+  actual: ["open","read","read","close"]` + `error# Assertion failed: events: expected "open" to appear before "open", but they are at index 0 and 0
+  actual: ["open","read","read","close"]`)
+}
+
+func TestSlice_ContainsAll(t *testing.T) {
+	m := new(internal.MockTestingT)
+	roles := []string{"admin", "editor", "viewer"}
+
+	m.Reset()
+	assert.ThatSlice(m, roles).ContainsAll([]string{"viewer", "admin"}).ContainsAll(nil)
+	assert.ThatSlice(m, roles).ContainsAny([]string{"owner", "editor"})
+	assert.ThatSlice(m, roles).ContainsNone([]string{"owner"}).ContainsNone(nil)
+	assert.ThatSlice(m, roles).ContainsOnly([]string{"viewer", "editor", "admin", "owner"})
+	assert.ThatSlice(m, []string{}).ContainsOnly(nil)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, roles).ContainsAll([]string{"owner", "admin", "guest", "owner"})
+	assert.ThatSlice(m, roles).ContainsAny([]string{"owner"})
+	assert.ThatSlice(m, roles).ContainsNone([]string{"viewer", "editor"})
+	assert.ThatSlice(m, roles).ContainsOnly([]string{"admin", "viewer"}, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: roles: expected slice to contain all the elements, but ["owner","guest"] are missing
+  actual: ["admin","editor","viewer"]
+elements: ["owner","admin","guest","owner"]` + `error# Assertion failed: roles: expected slice to contain any of the elements, but it contains none
+  actual: ["admin","editor","viewer"]
+elements: ["owner"]` + `error# Assertion failed: roles: expected slice to contain none of the elements, but "editor" is found at index 1
+  actual: ["admin","editor","viewer"]
+elements: ["viewer","editor"]` + `error# Assertion failed: roles: expected slice to contain only the elements, but "editor" at index 1 is not one of them
+  actual: ["admin","editor","viewer"]
+elements: ["admin","viewer"]
+ message: index is 0`)
+}
+
+func TestSlice_Duplicates(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	m.Reset()
+	tags := []string{"a", "b", "a", "c", "b", "a"}
+	assert.ThatSlice(m, tags).AllUnique("index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: tags: expected all elements in the slice to be unique, but duplicates are found
+   actual: ["a","b","a","c","b","a"]
+[0, 2, 5]: "a"
+   [1, 4]: "b"
+  message: index is 0`)
+
+	m.Reset()
+	ids := make([]int, 24)
+	for i := range ids {
+		ids[i] = i / 2
+	}
+	assert.ThatSlice(m, ids).AllUnique()
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: ids: expected all elements in the slice to be unique, but duplicates are found
+  actual: [0,0,1,1,2,2,3,3,4,4,5,5,6,6,7,7,8,8,9,9,10,10,11,11]
+  [0, 1]: 0
+  [2, 3]: 1
+  [4, 5]: 2
+  [6, 7]: 3
+  [8, 9]: 4
+[10, 11]: 5
+[12, 13]: 6
+[14, 15]: 7
+[16, 17]: 8
+[18, 19]: 9
+     ...: and 2 more duplicates`)
+}
+
+func TestSlice_UniqueBy(t *testing.T) {
+	m := new(internal.MockTestingT)
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "bob"}, {2, "alice"}, {1, "tom"}}
+
+	m.Reset()
+	assert.UniqueBy(assert.ThatSlice(m, users), func(u user) string { return u.Name })
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.UniqueBy(assert.ThatSlice(m, users), func(u user) int { return u.ID }, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: users: expected the keys of all elements in the slice to be unique, but duplicates are found
+  actual: [{"ID":1,"Name":"bob"},{"ID":2,"Name":"alice"},{"ID":1,"Name":"tom"}]
+  [0, 2]: 1
+ message: index is 0`)
+}
+
+func TestSlice_CountOf(t *testing.T) {
+	m := new(internal.MockTestingT)
+	rolls := []int{1, 6, 6, 3, 6}
+
+	m.Reset()
+	assert.ThatSlice(m, rolls).CountOf(6).Equal(3)
+	assert.ThatSlice(m, rolls).CountOf(2).Zero()
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatSlice(m, rolls).CountOf(6).LessThan(2)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: rolls.count_of: expected number to be less than 2, but it is 3`)
+}
+
+func TestSlice_GroupBy(t *testing.T) {
+	m := new(internal.MockTestingT)
+	words := []string{"go", "java", "c", "rust", "zig"}
+
+	m.Reset()
+	assert.GroupBy(assert.ThatSlice(m, words), func(s string) int { return len(s) }).
+		Length(4).ContainsKeyValue(4, 2).NotContainsKey(5)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.GroupBy(assert.ThatSlice(m, words), func(s string) bool { return len(s) > 2 }).
+		Equal(map[bool]int{true: 3, false: 1})
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: words.group_by: expected maps to be equal, but values for key 'false' are different
+  actual: {false:2, true:3}
+expected: {false:1, true:3}`)
+}
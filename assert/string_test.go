@@ -43,7 +43,7 @@ func TestString_Length(t *testing.T) {
 	assert.ThatString(m, "0").Require().Length(0, "index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to have length 0, but it has length 1
   actual: "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -97,7 +97,7 @@ func TestString_Blank(t *testing.T) {
 	assert.ThatString(m, "hello").Require().Blank("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to contain only whitespace, but it does not
   actual: "hello"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string - should pass as it's considered blank
 	m.Reset()
@@ -126,7 +126,7 @@ func TestString_Blank(t *testing.T) {
 	assert.ThatString(m, "text").Blank("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only whitespace, but it does not
   actual: "text"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_NotBlank(t *testing.T) {
@@ -148,7 +148,7 @@ func TestString_NotBlank(t *testing.T) {
 	assert.ThatString(m, " \n  ").Require().NotBlank("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be non-blank, but it is blank
   actual: " \n  "
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string - should fail
 	m.Reset()
@@ -177,7 +177,7 @@ func TestString_NotBlank(t *testing.T) {
 	assert.ThatString(m, "  ").NotBlank("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be non-blank, but it is blank
   actual: "  "
- message: "custom failure message"`)
+ message: custom failure message`)
 
 	// Test with single character - should pass
 	m.Reset()
@@ -206,7 +206,7 @@ expected: "1"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected strings to be equal, but they are not
   actual: "0"
 expected: "1"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty strings
 	m.Reset()
@@ -229,7 +229,7 @@ expected: "再见世界"`)
 	longStr := strings.Repeat("a", 1000)
 	m.Reset()
 	assert.ThatString(m, longStr).Equal(longStr + "x")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be equal, but they are not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longStr: expected strings to be equal, but they are not
   actual: "` + longStr + `"
 expected: "` + longStr + `x"`)
 
@@ -246,7 +246,7 @@ expected: "  "`)
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be equal, but they are not
   actual: "actual"
 expected: "expected"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_NotEqual(t *testing.T) {
@@ -270,7 +270,7 @@ expected: "0"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected strings to be different, but they are equal
   actual: "0"
 expected: "0"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty strings - failure case
 	m.Reset()
@@ -297,7 +297,7 @@ expected: "hello\nworld\t!"`)
 	longStr := strings.Repeat("a", 1000)
 	m.Reset()
 	assert.ThatString(m, longStr).NotEqual(longStr)
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be different, but they are equal
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longStr: expected strings to be different, but they are equal
   actual: "` + longStr + `"
 expected: "` + longStr + `"`)
 
@@ -314,7 +314,7 @@ expected: "   "`)
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be different, but they are equal
   actual: "actual"
 expected: "actual"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_EqualFold(t *testing.T) {
@@ -338,7 +338,7 @@ expected: "Hello, Jimmy!"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected strings to be equal (case-insensitive), but they are not
   actual: "hello, world!"
 expected: "Hello, Jimmy!"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty strings
 	m.Reset()
@@ -362,7 +362,7 @@ expected: "ПОКА"`)
 	expectedLongStr := strings.Repeat("a", 1000)
 	m.Reset()
 	assert.ThatString(m, longStr).EqualFold(expectedLongStr + "x")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be equal (case-insensitive), but they are not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longStr: expected strings to be equal (case-insensitive), but they are not
   actual: "` + longStr + `"
 expected: "` + expectedLongStr + `x"`)
 
@@ -384,7 +384,7 @@ expected: "  "`)
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be equal (case-insensitive), but they are not
   actual: "actual"
 expected: "expected"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_JSONEqual(t *testing.T) {
@@ -422,7 +422,7 @@ expected: "[{\"b\":1},{\"a\":0}]"`)
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected strings to be JSON-equal, but they are not
   actual: "{\"a\":0}"
 expected: "{\"a\":1}"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with nested JSON objects - failure case
 	m.Reset()
@@ -458,7 +458,7 @@ expected: "{\"invalid\":}"
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected strings to be JSON-equal, but they are not
   actual: "{\"actual\":true}"
 expected: "{\"expected\":false}"
- message: "custom failure message"`)
+ message: custom failure message`)
 
 	// Test with whitespace differences (should still be equal as JSON)
 	m.Reset()
@@ -495,7 +495,7 @@ func TestString_Matches(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to match the pattern, but it does not
   actual: "there's no error"
  pattern: "an error"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string and non-empty pattern - should fail
 	m.Reset()
@@ -538,7 +538,7 @@ func TestString_Matches(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to match the pattern, but it does not
   actual: "test"
  pattern: "test\\d+"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_HasPrefix(t *testing.T) {
@@ -562,7 +562,7 @@ func TestString_HasPrefix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to start with the specified prefix, but it does not
   actual: "hello, world!"
   prefix: "Hello, Jimmy!"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string and non-empty prefix - should fail
 	m.Reset()
@@ -589,7 +589,7 @@ func TestString_HasPrefix(t *testing.T) {
 	longStr := strings.Repeat("a", 1000)
 	m.Reset()
 	assert.ThatString(m, longStr+"suffix").HasPrefix(longStr + "x")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to start with the specified prefix, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longStr+"suffix": expected string to start with the specified prefix, but it does not
   actual: "` + longStr + `suffix"
   prefix: "` + longStr + `x"`)
 
@@ -606,7 +606,7 @@ func TestString_HasPrefix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to start with the specified prefix, but it does not
   actual: "actual"
   prefix: "expected"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_HasSuffix(t *testing.T) {
@@ -630,7 +630,7 @@ func TestString_HasSuffix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to end with the specified suffix, but it does not
   actual: "hello, world!"
   suffix: "Hello, Jimmy!"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string and non-empty suffix - should fail
 	m.Reset()
@@ -672,7 +672,7 @@ func TestString_HasSuffix(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to end with the specified suffix, but it does not
   actual: "actual"
   suffix: "expected"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_Contains(t *testing.T) {
@@ -696,7 +696,7 @@ func TestString_Contains(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to contain the specified substring, but it does not
   actual: "hello, world!"
      sub: "Hello, Jimmy!"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string and non-empty substring - should fail
 	m.Reset()
@@ -723,7 +723,7 @@ func TestString_Contains(t *testing.T) {
 	longStr := strings.Repeat("a", 1000)
 	m.Reset()
 	assert.ThatString(m, "prefix"+longStr+"suffix").Contains(longStr + "x")
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain the specified substring, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: "prefix"+longStr+"suffix": expected string to contain the specified substring, but it does not
   actual: "prefix` + longStr + `suffix"
      sub: "` + longStr + `x"`)
 
@@ -738,7 +738,7 @@ func TestString_Contains(t *testing.T) {
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain the specified substring, but it does not
   actual: "actual"
      sub: "expected"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsLowerCase(t *testing.T) {
@@ -760,7 +760,7 @@ func TestString_IsLowerCase(t *testing.T) {
 	assert.ThatString(m, "Hello").Require().IsLowerCase("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be all lowercase, but it is not
   actual: "Hello"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -801,7 +801,7 @@ func TestString_IsLowerCase(t *testing.T) {
 	assert.ThatString(m, "Actual").IsLowerCase("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be all lowercase, but it is not
   actual: "Actual"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsUpperCase(t *testing.T) {
@@ -823,7 +823,7 @@ func TestString_IsUpperCase(t *testing.T) {
 	assert.ThatString(m, "Hello").Require().IsUpperCase("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be all uppercase, but it is not
   actual: "Hello"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -864,7 +864,7 @@ func TestString_IsUpperCase(t *testing.T) {
 	assert.ThatString(m, "Actual").IsUpperCase("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be all uppercase, but it is not
   actual: "Actual"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsNumeric(t *testing.T) {
@@ -886,7 +886,7 @@ func TestString_IsNumeric(t *testing.T) {
 	assert.ThatString(m, "123a456").Require().IsNumeric("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to contain only digits, but it does not
   actual: "123a456"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -928,7 +928,7 @@ func TestString_IsNumeric(t *testing.T) {
 	longAlphanumeric := longNumeric + "a"
 	m.Reset()
 	assert.ThatString(m, longAlphanumeric).IsNumeric()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only digits, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longAlphanumeric: expected string to contain only digits, but it does not
   actual: "` + longAlphanumeric + `"`)
 
 	// Test with custom message - failure case
@@ -936,7 +936,7 @@ func TestString_IsNumeric(t *testing.T) {
 	assert.ThatString(m, "123a45").IsNumeric("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only digits, but it does not
   actual: "123a45"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsAlpha(t *testing.T) {
@@ -958,7 +958,7 @@ func TestString_IsAlpha(t *testing.T) {
 	assert.ThatString(m, "abc123").Require().IsAlpha("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to contain only letters, but it does not
   actual: "abc123"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -994,7 +994,7 @@ func TestString_IsAlpha(t *testing.T) {
 	longAlphanumeric := longAlpha + "1"
 	m.Reset()
 	assert.ThatString(m, longAlphanumeric).IsAlpha()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only letters, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longAlphanumeric: expected string to contain only letters, but it does not
   actual: "` + longAlphanumeric + `"`)
 
 	// Test with custom message - failure case
@@ -1002,7 +1002,7 @@ func TestString_IsAlpha(t *testing.T) {
 	assert.ThatString(m, "abc123").IsAlpha("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only letters, but it does not
   actual: "abc123"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsAlphaNumeric(t *testing.T) {
@@ -1024,7 +1024,7 @@ func TestString_IsAlphaNumeric(t *testing.T) {
 	assert.ThatString(m, "abc@123").Require().IsAlphaNumeric("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to contain only letters and digits, but it does not
   actual: "abc@123"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string
 	m.Reset()
@@ -1061,7 +1061,7 @@ func TestString_IsAlphaNumeric(t *testing.T) {
 	longAlphanumeric := longAlpha + longNumeric
 	m.Reset()
 	assert.ThatString(m, longAlphanumeric+"!").IsAlphaNumeric()
-	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only letters and digits, but it does not
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: longAlphanumeric+"!": expected string to contain only letters and digits, but it does not
   actual: "` + longAlphanumeric + `!"`)
 
 	// Test with custom message - failure case
@@ -1069,7 +1069,7 @@ func TestString_IsAlphaNumeric(t *testing.T) {
 	assert.ThatString(m, "abc123@").IsAlphaNumeric("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to contain only letters and digits, but it does not
   actual: "abc123@"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsEmail(t *testing.T) {
@@ -1091,7 +1091,7 @@ func TestString_IsEmail(t *testing.T) {
 	assert.ThatString(m, "invalid-email").Require().IsEmail("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be a valid email, but it is not
   actual: "invalid-email"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string - should fail
 	m.Reset()
@@ -1140,7 +1140,7 @@ func TestString_IsEmail(t *testing.T) {
 	assert.ThatString(m, "invalid-email").IsEmail("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be a valid email, but it is not
   actual: "invalid-email"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsURL(t *testing.T) {
@@ -1162,7 +1162,7 @@ func TestString_IsURL(t *testing.T) {
 	assert.ThatString(m, "invalid-url").Require().IsURL("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be a valid URL, but it is not
   actual: "invalid-url"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string - should fail
 	m.Reset()
@@ -1206,7 +1206,7 @@ func TestString_IsURL(t *testing.T) {
 	assert.ThatString(m, "invalid-url").IsURL("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be a valid URL, but it is not
   actual: "invalid-url"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsIPv4(t *testing.T) {
@@ -1228,7 +1228,7 @@ func TestString_IsIPv4(t *testing.T) {
 	assert.ThatString(m, "invalid-ip").Require().IsIPv4("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be a valid IP, but it is not
   actual: "invalid-ip"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test with empty string - should fail
 	m.Reset()
@@ -1287,7 +1287,7 @@ func TestString_IsIPv4(t *testing.T) {
 	assert.ThatString(m, "invalid-ip").IsIPv4("custom failure message")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be a valid IP, but it is not
   actual: "invalid-ip"
- message: "custom failure message"`)
+ message: custom failure message`)
 }
 
 func TestString_IsHex(t *testing.T) {
@@ -1309,7 +1309,7 @@ func TestString_IsHex(t *testing.T) {
 	assert.ThatString(m, "abcdefg").Require().IsHex("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be a valid hexadecimal, but it is not
   actual: "abcdefg"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test various valid hexadecimal strings
 	m.Reset()
@@ -1346,7 +1346,7 @@ func TestString_IsHex(t *testing.T) {
 	assert.ThatString(m, "invalid-hex").IsHex("This should be a valid hexadecimal string")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be a valid hexadecimal, but it is not
   actual: "invalid-hex"
- message: "This should be a valid hexadecimal string"`)
+ message: This should be a valid hexadecimal string`)
 }
 
 func TestString_IsBase64(t *testing.T) {
@@ -1368,7 +1368,7 @@ func TestString_IsBase64(t *testing.T) {
 	assert.ThatString(m, "invalid-base64").Require().IsBase64("index is 0")
 	assert.ThatString(t, m.String()).Equal(`fatal# Assertion failed: expected string to be a valid Base64, but it is not
   actual: "invalid-base64"
- message: "index is 0"`)
+ message: index is 0`)
 
 	// Test various valid Base64 strings
 	m.Reset()
@@ -1405,5 +1405,5 @@ func TestString_IsBase64(t *testing.T) {
 	assert.ThatString(m, "invalid-base64!").IsBase64("This should be a valid Base64 string")
 	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: expected string to be a valid Base64, but it is not
   actual: "invalid-base64!"
- message: "This should be a valid Base64 string"`)
+ message: This should be a valid Base64 string`)
 }
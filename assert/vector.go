@@ -0,0 +1,195 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// maxMismatches is the maximum number of mismatched elements printed in failures.
+const maxMismatches = 10
+
+// VectorAssertion encapsulates a numeric vector and a test handler for making
+// assertions on it, comparing elements within a tolerance.
+type VectorAssertion[T Number] struct {
+	AssertionBase[*VectorAssertion[T]]
+	v []T
+}
+
+// ThatVector returns a VectorAssertion for the given testing object and vector.
+func ThatVector[T Number](t internal.TestingT, v []T) *VectorAssertion[T] {
+	a := &VectorAssertion[T]{v: v}
+	a.AssertionBase = NewAssertionBase(t, a)
+	return a
+}
+
+// Length asserts that the vector has the expected length.
+func (a *VectorAssertion[T]) Length(length int, msg ...any) *VectorAssertion[T] {
+	a.t.Helper()
+	if len(a.v) != length {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.length", length, len(a.v)),
+		}, msg...)
+	}
+	return a
+}
+
+// InDelta asserts that each element of the vector is within the delta range
+// of the expected element, like NumberAssertion.InDelta. Only the elements
+// out of range are printed in the failure.
+func (a *VectorAssertion[T]) InDelta(expect []T, delta T, msg ...any) *VectorAssertion[T] {
+	a.t.Helper()
+	if len(a.v) != len(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.length", len(expect), len(a.v)),
+		}, msg...)
+		return a
+	}
+	var cells []mismatch[T]
+	for i := range a.v {
+		if !inDelta(a.v[i], expect[i], delta) {
+			cells = append(cells, mismatch[T]{index: vectorIndex(i), actual: a.v[i], expect: expect[i]})
+		}
+	}
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.in_delta", delta, len(cells), len(a.v)),
+			Details: mismatchDetails(a.text, cells, false),
+		}, msg...)
+	}
+	return a
+}
+
+// InEpsilon asserts that the relative error of each element of the vector and
+// the expected element is at most relErr, like NumberAssertion.InEpsilon. Only
+// the elements out of range are printed in the failure.
+func (a *VectorAssertion[T]) InEpsilon(expect []T, relErr float64, msg ...any) *VectorAssertion[T] {
+	a.t.Helper()
+	if len(a.v) != len(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.length", len(expect), len(a.v)),
+		}, msg...)
+		return a
+	}
+	var cells []mismatch[T]
+	for i := range a.v {
+		if !inEpsilon(a.v[i], expect[i], relErr) {
+			cells = append(cells, mismatch[T]{index: vectorIndex(i), actual: a.v[i], expect: expect[i]})
+		}
+	}
+	if len(cells) > 0 {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.in_epsilon", formatFloat[T](relErr), len(cells), len(a.v)),
+			Details: mismatchDetails(a.text, cells, true),
+		}, msg...)
+	}
+	return a
+}
+
+// L2Distance returns a NumberAssertion for the Euclidean distance between the
+// vector and the expected vector, labelled like "v.l2_distance".
+func (a *VectorAssertion[T]) L2Distance(expect []T) *NumberAssertion[float64] {
+	a.t.Helper()
+	if len(a.v) != len(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.length", len(expect), len(a.v)),
+		})
+		return derivedNumber(&a.AssertionBase, math.NaN(), "l2_distance", false)
+	}
+	var sum float64
+	for i := range a.v {
+		d := float64(a.v[i]) - float64(expect[i])
+		sum += d * d
+	}
+	return derivedNumber(&a.AssertionBase, math.Sqrt(sum), "l2_distance", true)
+}
+
+// MaxAbsDiff returns a NumberAssertion for the largest absolute difference
+// between the elements of the vector and the expected vector, labelled like
+// "v.max_abs_diff".
+func (a *VectorAssertion[T]) MaxAbsDiff(expect []T) *NumberAssertion[float64] {
+	a.t.Helper()
+	if len(a.v) != len(expect) {
+		a.Fail(internal.Failure{
+			Summary: a.text("vector.length", len(expect), len(a.v)),
+		})
+		return derivedNumber(&a.AssertionBase, math.NaN(), "max_abs_diff", false)
+	}
+	var d float64
+	for i := range a.v {
+		d = maxAbsDiff(d, a.v[i], expect[i])
+	}
+	return derivedNumber(&a.AssertionBase, d, "max_abs_diff", true)
+}
+
+// mismatch is an element of a vector or matrix that differs from the expected element.
+type mismatch[T Number] struct {
+	index  string
+	actual T
+	expect T
+}
+
+// mismatchDetails returns the mismatched elements as failure details, named by
+// their indices and showing the absolute or relative errors. At most
+// maxMismatches elements are listed.
+func mismatchDetails[T Number](text func(string, ...any) string, cells []mismatch[T], relative bool) []internal.Detail {
+	var details []internal.Detail
+	for i, c := range cells {
+		if i == maxMismatches {
+			details = append(details, internal.Detail{Name: "...", Value: text("vector.more", len(cells)-i)})
+			break
+		}
+		abs, rel := relativeError(c.actual, c.expect)
+		var value string
+		if relative {
+			value = text("vector.cell.rel_error", c.actual, c.expect, formatFloat[T](rel))
+		} else {
+			value = text("vector.cell.abs_error", c.actual, c.expect, formatFloat[T](abs))
+		}
+		details = append(details, internal.Detail{Name: c.index, Value: value})
+	}
+	return details
+}
+
+// inEpsilon reports whether the relative error of x and y is at most relErr.
+func inEpsilon[T Number](x, y T, relErr float64) bool {
+	if x == y {
+		return true
+	}
+	_, rel := relativeError(x, y)
+	return rel <= relErr
+}
+
+// maxAbsDiff returns the larger of d and |x-y|, NaN if any of them is NaN.
+func maxAbsDiff[T Number](d float64, x, y T) float64 {
+	var diff float64
+	if x != y {
+		diff, _ = relativeError(x, y)
+	}
+	if math.IsNaN(diff) {
+		return diff
+	}
+	return max(d, diff)
+}
+
+// vectorIndex formats the index of a vector element, like "[3]".
+func vectorIndex(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+func TestVector_InDelta(t *testing.T) {
+	m := new(internal.MockTestingT)
+	v := []float64{0.1 + 0.2, 1.5, -2}
+
+	m.Reset()
+	assert.ThatVector(m, v).Length(3).InDelta([]float64{0.3, 1.5, -2}, 0)
+	assert.ThatVector(m, v).InDelta([]float64{0.4, 1.4, -2.1}, 0.1)
+	assert.ThatVector(m, []int{1, 2}).InDelta([]int{2, 1}, 1)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatVector(m, v).InDelta([]float64{0.3, 1.2, -2, 0}, 0.1)
+	assert.ThatVector(m, v).InDelta([]float64{0.3, 1.2, math.NaN()}, 0.1, "index is 0")
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: v: expected vector to have length 4, but it has length 3` +
+		`error# Assertion failed: v: expected vector elements to be within ±0.1, but 2 of 3 elements are not
+     [1]: 1.5, expected 1.2 (abs err 0.30000000000000004)
+     [2]: -2, expected NaN (abs err NaN)
+ message: index is 0`)
+}
+
+func TestVector_InEpsilon(t *testing.T) {
+	m := new(internal.MockTestingT)
+	v := make([]float32, 15)
+	for i := range v {
+		v[i] = float32(i) + 1
+	}
+
+	m.Reset()
+	assert.ThatVector(m, v).InEpsilon(v, 0)
+	assert.ThatVector(m, []float32{100, 0}).InEpsilon([]float32{101, 0}, 0.01)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatVector(m, v).InEpsilon(make([]float32, 15), 0.5)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: v: expected vector elements to be within relative error 0.5, but 15 of 15 elements are not
+     [0]: 1, expected 0 (rel err 1)
+     [1]: 2, expected 0 (rel err 1)
+     [2]: 3, expected 0 (rel err 1)
+     [3]: 4, expected 0 (rel err 1)
+     [4]: 5, expected 0 (rel err 1)
+     [5]: 6, expected 0 (rel err 1)
+     [6]: 7, expected 0 (rel err 1)
+     [7]: 8, expected 0 (rel err 1)
+     [8]: 9, expected 0 (rel err 1)
+     [9]: 10, expected 0 (rel err 1)
+     ...: and 5 more elements`)
+}
+
+func TestVector_Distance(t *testing.T) {
+	m := new(internal.MockTestingT)
+	v := []float64{1, 2, 3}
+
+	m.Reset()
+	assert.ThatVector(m, v).L2Distance([]float64{4, 6, 3}).Equal(5)
+	assert.ThatVector(m, v).MaxAbsDiff([]float64{1.5, 0, 3}).Equal(2)
+	assert.ThatString(t, m.String()).Equal("")
+
+	m.Reset()
+	assert.ThatVector(m, v).L2Distance([]float64{1, 2, 4}).LessThan(0.5)
+	assert.ThatVector(m, v).MaxAbsDiff([]float64{1}).LessThan(0.5)
+	assert.ThatString(t, m.String()).Equal(`error# Assertion failed: v.l2_distance: expected number to be less than 0.5, but it is 1` +
+		`error# Assertion failed: v: expected vector to have length 1, but it has length 3`)
+}
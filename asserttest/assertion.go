@@ -0,0 +1,123 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asserttest
+
+import (
+	"regexp"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+// RecorderAssertion encapsulates a Recorder for making assertions on
+// what was reported to it.
+type RecorderAssertion struct {
+	assert.AssertionBase[*RecorderAssertion]
+	r *Recorder
+}
+
+// That returns a RecorderAssertion for the given test context and recorder.
+func That(t internal.TestingT, r *Recorder) *RecorderAssertion {
+	a := &RecorderAssertion{r: r}
+	a.AssertionBase = assert.NewAssertionBase(t, a)
+	return a
+}
+
+// Succeeded asserts that the test has not failed.
+func (a *RecorderAssertion) Succeeded(msg ...any) *RecorderAssertion {
+	a.Helper()
+	if a.r.Failed() {
+		a.Fail(internal.Failure{
+			Summary: a.Text("recorder.succeeded"),
+			Actual:  a.r.String(),
+		}, msg...)
+	}
+	return a
+}
+
+// Failed asserts that the test has failed.
+func (a *RecorderAssertion) Failed(msg ...any) *RecorderAssertion {
+	a.Helper()
+	if !a.r.Failed() {
+		a.Fail(internal.Failure{
+			Summary: a.Text("recorder.failed"),
+			Actual:  a.r.String(),
+		}, msg...)
+	}
+	return a
+}
+
+// Halted asserts that the test was stopped by Fatal, Fatalf or FailNow.
+func (a *RecorderAssertion) Halted(msg ...any) *RecorderAssertion {
+	a.Helper()
+	if !a.r.Halted() {
+		a.Fail(internal.Failure{
+			Summary: a.Text("recorder.halted"),
+			Actual:  a.r.String(),
+		}, msg...)
+	}
+	return a
+}
+
+// Skipped asserts that the test was skipped.
+func (a *RecorderAssertion) Skipped(msg ...any) *RecorderAssertion {
+	a.Helper()
+	if !a.r.Skipped() {
+		a.Fail(internal.Failure{
+			Summary: a.Text("recorder.skipped"),
+			Actual:  a.r.String(),
+		}, msg...)
+	}
+	return a
+}
+
+// Reported asserts that a message matching the regular expression
+// pattern was recorded at the given level.
+func (a *RecorderAssertion) Reported(level Level, pattern string, msg ...any) *RecorderAssertion {
+	a.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		a.Fail(internal.Failure{
+			Summary: a.Text("pattern.invalid"),
+			Details: []internal.Detail{{Name: "pattern", Value: pattern}, {Name: "error", Value: err.Error()}},
+		}, msg...)
+		return a
+	}
+	for _, s := range a.r.Messages(level) {
+		if re.MatchString(s) {
+			return a
+		}
+	}
+	a.Fail(internal.Failure{
+		Summary: a.Text("recorder.reported", level),
+		Actual:  a.r.String(),
+		Details: []internal.Detail{{Name: "pattern", Value: pattern}},
+	}, msg...)
+	return a
+}
+
+// CalledHelper asserts that Helper was called at least once, so that
+// failures are reported at the line of the caller.
+func (a *RecorderAssertion) CalledHelper(msg ...any) *RecorderAssertion {
+	a.Helper()
+	if a.r.HelperCalls() == 0 {
+		a.Fail(internal.Failure{
+			Summary: a.Text("recorder.helper"),
+		}, msg...)
+	}
+	return a
+}
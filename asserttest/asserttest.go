@@ -0,0 +1,291 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package asserttest provides utilities for testing user-defined assertions
+// and test helpers built on the `assert` package.
+//
+// A Recorder is a TestingT that records every call made to it, so that the
+// failures reported by an assertion can be verified:
+//
+//	r := asserttest.Run("order", func(t *asserttest.Recorder) {
+//		order.ThatOrder(t, o).IsPaid()
+//	})
+//	asserttest.That(t, r).Failed().Reported(asserttest.LevelError, "to be paid")
+package asserttest
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Level is the kind of a recorded call.
+type Level string
+
+// The levels of the recorded calls.
+const (
+	LevelLog   Level = "log"   // Log or Logf
+	LevelError Level = "error" // Error or Errorf
+	LevelFatal Level = "fatal" // Fatal or Fatalf
+	LevelSkip  Level = "skip"  // Skip or Skipf
+)
+
+// Call is a call recorded by a Recorder.
+type Call struct {
+	Level   Level
+	Message string
+}
+
+// String returns the call as "level# message".
+func (c Call) String() string {
+	return string(c.Level) + "# " + c.Message
+}
+
+// Recorder simulates *testing.T and records every call made to it.
+// Like in *testing.T, Fatal, FailNow, Skip and SkipNow stop the calling
+// goroutine by runtime.Goexit, so call them through Run. It's safe for
+// concurrent use.
+type Recorder struct {
+	mutex    sync.Mutex
+	name     string
+	calls    []Call
+	helpers  int
+	cleanups []func()
+	failed   bool
+	halted   bool
+	skipped  bool
+}
+
+// NewRecorder creates a Recorder for the test of the given name.
+func NewRecorder(name string) *Recorder {
+	return &Recorder{name: name}
+}
+
+// Run runs fn with a new Recorder for the test of the given name, in a
+// separate goroutine, and returns the Recorder after fn and the cleanup
+// functions have returned. A panic in fn is propagated to the caller.
+func Run(name string, fn func(t *Recorder)) *Recorder {
+	r := NewRecorder(name)
+	var (
+		panicked bool
+		value    any
+	)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			// recover returns nil when fn calls runtime.Goexit.
+			if v := recover(); v != nil {
+				panicked, value = true, v
+			}
+		}()
+		defer r.runCleanups()
+		fn(r)
+	}()
+	<-done
+	if panicked {
+		panic(value)
+	}
+	return r
+}
+
+// runCleanups calls the cleanup functions in last added, first called order.
+func (r *Recorder) runCleanups() {
+	for {
+		r.mutex.Lock()
+		n := len(r.cleanups)
+		if n == 0 {
+			r.mutex.Unlock()
+			return
+		}
+		fn := r.cleanups[n-1]
+		r.cleanups = r.cleanups[:n-1]
+		r.mutex.Unlock()
+		fn()
+	}
+}
+
+// record appends a call with the message formatted like *testing.T does.
+func (r *Recorder) record(level Level, msg string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.calls = append(r.calls, Call{Level: level, Message: msg})
+	switch level {
+	case LevelError:
+		r.failed = true
+	case LevelFatal:
+		r.failed, r.halted = true, true
+	case LevelSkip:
+		r.skipped = true
+	}
+}
+
+// sprint formats the arguments like fmt.Sprintln, without the newline.
+func sprint(args ...any) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
+
+// Name returns the name of the test.
+func (r *Recorder) Name() string {
+	return r.name
+}
+
+// Helper records a call of Helper.
+func (r *Recorder) Helper() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.helpers++
+}
+
+// Log records a log message.
+func (r *Recorder) Log(args ...any) {
+	r.record(LevelLog, sprint(args...))
+}
+
+// Logf records a formatted log message.
+func (r *Recorder) Logf(format string, args ...any) {
+	r.record(LevelLog, fmt.Sprintf(format, args...))
+}
+
+// Error records an error message and marks the test as failed.
+func (r *Recorder) Error(args ...any) {
+	r.record(LevelError, sprint(args...))
+}
+
+// Errorf records a formatted error message and marks the test as failed.
+func (r *Recorder) Errorf(format string, args ...any) {
+	r.record(LevelError, fmt.Sprintf(format, args...))
+}
+
+// Fatal records a fatal message, marks the test as failed and stops
+// the calling goroutine.
+func (r *Recorder) Fatal(args ...any) {
+	r.record(LevelFatal, sprint(args...))
+	runtime.Goexit()
+}
+
+// Fatalf records a formatted fatal message, marks the test as failed
+// and stops the calling goroutine.
+func (r *Recorder) Fatalf(format string, args ...any) {
+	r.record(LevelFatal, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// Fail marks the test as failed without recording a message.
+func (r *Recorder) Fail() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.failed = true
+}
+
+// FailNow marks the test as failed and stops the calling goroutine.
+func (r *Recorder) FailNow() {
+	r.mutex.Lock()
+	r.failed, r.halted = true, true
+	r.mutex.Unlock()
+	runtime.Goexit()
+}
+
+// Skip records a skip message, marks the test as skipped and stops
+// the calling goroutine.
+func (r *Recorder) Skip(args ...any) {
+	r.record(LevelSkip, sprint(args...))
+	runtime.Goexit()
+}
+
+// Skipf records a formatted skip message, marks the test as skipped
+// and stops the calling goroutine.
+func (r *Recorder) Skipf(format string, args ...any) {
+	r.record(LevelSkip, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// SkipNow marks the test as skipped and stops the calling goroutine.
+func (r *Recorder) SkipNow() {
+	r.mutex.Lock()
+	r.skipped = true
+	r.mutex.Unlock()
+	runtime.Goexit()
+}
+
+// Cleanup registers a function to be called by Run when the test
+// function returns, in last added, first called order.
+func (r *Recorder) Cleanup(fn func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cleanups = append(r.cleanups, fn)
+}
+
+// Failed reports whether the test has failed.
+func (r *Recorder) Failed() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.failed
+}
+
+// Halted reports whether the test was stopped by Fatal, Fatalf or FailNow.
+func (r *Recorder) Halted() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.halted
+}
+
+// Skipped reports whether the test was skipped.
+func (r *Recorder) Skipped() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.skipped
+}
+
+// Calls returns the recorded calls, in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// Messages returns the messages of the recorded calls at the given level.
+func (r *Recorder) Messages(level Level) []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var ret []string
+	for _, c := range r.calls {
+		if c.Level == level {
+			ret = append(ret, c.Message)
+		}
+	}
+	return ret
+}
+
+// HelperCalls returns the number of calls of Helper.
+func (r *Recorder) HelperCalls() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.helpers
+}
+
+// String returns the recorded calls, one per line.
+func (r *Recorder) String() string {
+	var sb strings.Builder
+	for i, c := range r.Calls() {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(c.String())
+	}
+	return sb.String()
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asserttest_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/asserttest"
+	"github.com/go-spring/gs-assert/internal"
+	"github.com/go-spring/gs-assert/require"
+)
+
+// TestMain keeps the failure messages checked by the tests independent of
+// the GS_ASSERT_* environment variables.
+func TestMain(m *testing.M) {
+	internal.PinTestEnv()
+	os.Exit(m.Run())
+}
+
+func TestRun(t *testing.T) {
+	var steps []string
+	r := asserttest.Run("TestOrder", func(t *asserttest.Recorder) {
+		t.Cleanup(func() { steps = append(steps, "cleanup 1") })
+		t.Cleanup(func() { steps = append(steps, "cleanup 2") })
+		t.Log("start", 1)
+		t.Errorf("step %d failed", 2)
+		t.Fatal("stop")
+		steps = append(steps, "unreachable")
+	})
+	assert.ThatSlice(t, steps).Equal([]string{"cleanup 2", "cleanup 1"})
+	assert.ThatString(t, r.Name()).Equal("TestOrder")
+	assert.That(t, r.Failed()).True()
+	assert.That(t, r.Halted()).True()
+	assert.That(t, r.Skipped()).False()
+	assert.ThatSlice(t, r.Calls()).Equal([]asserttest.Call{
+		{Level: asserttest.LevelLog, Message: "start 1"},
+		{Level: asserttest.LevelError, Message: "step 2 failed"},
+		{Level: asserttest.LevelFatal, Message: "stop"},
+	})
+	assert.ThatSlice(t, r.Messages(asserttest.LevelError)).Equal([]string{"step 2 failed"})
+	assert.ThatString(t, r.String()).Equal("log# start 1\nerror# step 2 failed\nfatal# stop")
+
+	r = asserttest.Run("TestSkip", func(t *asserttest.Recorder) {
+		t.Skip("not supported")
+	})
+	assert.That(t, r.Skipped()).True()
+	assert.That(t, r.Failed()).False()
+
+	assert.Panic(t, func() {
+		asserttest.Run("TestPanic", func(t *asserttest.Recorder) {
+			panic("boom")
+		})
+	}, "boom")
+}
+
+func TestRecorder_Assertions(t *testing.T) {
+	r := asserttest.Run("TestRequire", func(t *asserttest.Recorder) {
+		require.ThatNumber(t, 1).Equal(2)
+		t.Error("unreachable")
+	})
+	asserttest.That(t, r).
+		Failed().
+		Halted().
+		CalledHelper().
+		Reported(asserttest.LevelFatal, `to be equal to 2, but it is 1`)
+	assert.ThatSlice(t, r.Messages(asserttest.LevelError)).Empty()
+
+	r = asserttest.Run("TestAssert", func(t *asserttest.Recorder) {
+		assert.ThatNumber(t, 1).Equal(1)
+	})
+	asserttest.That(t, r).Succeeded()
+
+	r = asserttest.Run("TestFailed", func(t *asserttest.Recorder) {
+		t.Error("oops")
+	})
+	m := asserttest.NewRecorder("TestCheck")
+	a := asserttest.That(m, r).Check().
+		Succeeded().
+		Halted().
+		Skipped().
+		Reported(asserttest.LevelFatal, "oops").
+		Reported(asserttest.LevelError, "(")
+	assert.ThatSlice(t, a.Failures()).Equal([]string{
+		"r: expected the test to succeed, but it failed\n  actual: error# oops",
+		"r: expected the test to be stopped by Fatal, but it was not\n  actual: error# oops",
+		"r: expected the test to be skipped, but it was not\n  actual: error# oops",
+		"r: expected a fatal message matching the pattern, but there is none\n  actual: error# oops\n pattern: oops",
+		"r: invalid pattern\n pattern: (\n   error: error parsing regexp: missing closing ): `(`",
+	})
+	assert.ThatSlice(t, m.Calls()).Empty()
+
+	empty := asserttest.NewRecorder("TestEmpty")
+	a = asserttest.That(m, empty).Check().Failed().CalledHelper()
+	assert.ThatSlice(t, a.Failures()).Equal([]string{
+		"empty: expected the test to fail, but it did not",
+		"empty: expected Helper to be called, but it was not",
+	})
+}
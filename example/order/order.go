@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package order shows how to write a user-defined assertion type that
+// behaves like the built-in ones, in both the `assert` and `require` styles:
+//
+//	order.ThatOrder(t, o).IsPaid().HasTotal(100)               // continues on failure
+//	require.Of(order.ThatOrder(t, o)).IsPaid().HasTotal(100)   // stops on failure
+package order
+
+import (
+	"fmt"
+
+	"github.com/go-spring/gs-assert/assert"
+)
+
+// Order is the domain type under test.
+type Order struct {
+	ID    string
+	Total int64
+	Paid  bool
+}
+
+// OrderAssertion encapsulates an order for making assertions on it.
+// It embeds assert.AssertionBase, which provides Require, Check, As,
+// WithContext, Passed and Failures, as well as Helper, Fail, T and Text.
+type OrderAssertion struct {
+	assert.AssertionBase[*OrderAssertion]
+	v *Order
+}
+
+// ThatOrder returns an OrderAssertion for the given test context and order.
+// Like the built-in constructors, its name starts with "That", so that
+// failures are labelled with the source expression of the order.
+func ThatOrder(t assert.TestingT, v *Order) *OrderAssertion {
+	a := &OrderAssertion{v: v}
+	a.AssertionBase = assert.NewAssertionBase(t, a)
+	return a
+}
+
+// IsPaid asserts that the order is paid.
+func (a *OrderAssertion) IsPaid(msg ...any) *OrderAssertion {
+	a.Helper()
+	if !a.v.Paid {
+		a.Fail(assert.Failure{
+			Summary: fmt.Sprintf("expected order %s to be paid, but it is not", a.v.ID),
+		}, msg...)
+	}
+	return a
+}
+
+// HasTotal asserts that the total of the order is equal to the expected value.
+func (a *OrderAssertion) HasTotal(expect int64, msg ...any) *OrderAssertion {
+	a.Helper()
+	if a.v.Total != expect {
+		a.Fail(assert.Failure{
+			Summary:  fmt.Sprintf("expected order %s to have total %d, but it has %d", a.v.ID, expect, a.v.Total),
+			Actual:   fmt.Sprint(a.v.Total),
+			Expected: fmt.Sprint(expect),
+		}, msg...)
+	}
+	return a
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package order_test
+
+import (
+	"testing"
+
+	"example/order"
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/require"
+)
+
+func TestThatOrder(t *testing.T) {
+	o := &order.Order{ID: "A-1", Total: 100, Paid: true}
+	order.ThatOrder(t, o).IsPaid().HasTotal(100)
+	require.Of(order.ThatOrder(t, o)).IsPaid().HasTotal(100)
+
+	// Check mode reports the failures to the caller instead of the test.
+	pending := &order.Order{ID: "A-2", Total: 80}
+	a := order.ThatOrder(t, pending).Check().IsPaid().HasTotal(100)
+	assert.That(t, a.Passed()).False()
+	assert.ThatSlice(t, a.Failures()).Equal([]string{
+		"pending: expected order A-2 to be paid, but it is not",
+		"pending: expected order A-2 to have total 100, but it has 80\n  actual: 80\nexpected: 100",
+	})
+}
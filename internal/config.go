@@ -0,0 +1,144 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Environment variables controlling the defaults of Config, besides
+// EnvColor, EnvLayout and EnvLanguage.
+const (
+	EnvDiffContext    = "GS_ASSERT_DIFF_CONTEXT"
+	EnvMaxDepth       = "GS_ASSERT_MAX_DEPTH"
+	EnvMaxElements    = "GS_ASSERT_MAX_ELEMENTS"
+	EnvMaxString      = "GS_ASSERT_MAX_STRING"
+	EnvFloatTolerance = "GS_ASSERT_FLOAT_TOLERANCE"
+)
+
+// Config controls the behaviour of assertions.
+type Config struct {
+	Style                  // colors, layout and width of failures printed as text
+	Language       string  // language of failure messages, e.g. "en" or "zh"
+	DiffContext    int     // unchanged lines around each change in diffs of multi-line values, negative to disable diffs
+	MaxDepth       int     // depth of nested values printed in failures, 0 for unlimited
+	MaxElements    int     // elements of a collection printed in failures, 0 for unlimited
+	MaxString      int     // bytes of a string printed in failures, 0 for unlimited
+	FloatTolerance float64 // relative tolerance of number equality between floats, 0 for exact comparison and the default of ApproxEqual
+}
+
+// PrettyOptions returns the limits of printed values.
+func (c *Config) PrettyOptions() PrettyOptions {
+	return PrettyOptions{
+		MaxDepth:    c.MaxDepth,
+		MaxElements: c.MaxElements,
+		MaxString:   c.MaxString,
+		Width:       80,
+	}
+}
+
+// NewConfig creates the configuration selected by the environment
+// variables, read by getenv, see NewStyle for the style.
+func NewConfig(getenv func(string) string, terminal bool) Config {
+	c := Config{
+		Style:       NewStyle(getenv, terminal),
+		Language:    English,
+		DiffContext: 3,
+		MaxDepth:    10,
+		MaxElements: 100,
+		MaxString:   1000,
+	}
+	if lang := getenv(EnvLanguage); lang != "" {
+		c.Language = NormalizeLanguage(lang)
+	}
+	for name, p := range map[string]*int{
+		EnvDiffContext: &c.DiffContext,
+		EnvMaxDepth:    &c.MaxDepth,
+		EnvMaxElements: &c.MaxElements,
+		EnvMaxString:   &c.MaxString,
+	} {
+		if n, err := strconv.Atoi(getenv(name)); err == nil {
+			*p = n
+		}
+	}
+	if f, err := strconv.ParseFloat(getenv(EnvFloatTolerance), 64); err == nil && f >= 0 {
+		c.FloatTolerance = f
+	}
+	return c
+}
+
+// envConfig returns the configuration selected by the environment variables.
+var envConfig = sync.OnceValue(func() Config {
+	return NewConfig(os.Getenv, isTerminal(os.Stdout))
+})
+
+var (
+	globalConfig atomic.Pointer[Config]
+	testConfigs  sync.Map // TestingT -> *Config
+)
+
+// GlobalConfig returns the configuration shared by all tests. Unless changed
+// by Configure, it's the configuration selected by the environment variables.
+func GlobalConfig() Config {
+	if c := globalConfig.Load(); c != nil {
+		return *c
+	}
+	return envConfig()
+}
+
+// Configure changes the configuration shared by all tests with fn.
+// Passing nil restores the configuration selected by the environment variables.
+func Configure(fn func(c *Config)) {
+	if fn == nil {
+		globalConfig.Store(nil)
+		return
+	}
+	for {
+		old := globalConfig.Load()
+		c := envConfig()
+		if old != nil {
+			c = *old
+		}
+		fn(&c)
+		if globalConfig.CompareAndSwap(old, &c) {
+			return
+		}
+	}
+}
+
+// WithConfig changes the configuration of the assertions made with t, on
+// top of the global configuration. The change is undone when the test
+// finishes if t supports `Cleanup`, as *testing.T does; otherwise it lasts
+// as long as the program. Subtests don't inherit the configuration.
+func WithConfig(t TestingT, fn func(c *Config)) {
+	c := ConfigFor(t)
+	fn(&c)
+	if _, loaded := testConfigs.Swap(t, &c); !loaded {
+		Cleanup(t, func() { testConfigs.Delete(t) })
+	}
+}
+
+// ConfigFor returns the configuration of the assertions made with t.
+func ConfigFor(t TestingT) Config {
+	if c, ok := testConfigs.Load(t); ok {
+		return *c.(*Config)
+	}
+	return GlobalConfig()
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffCells bounds the size of the table of the longest common subsequence
+// of the changed lines. Larger changes are diffed as a removal of all their
+// actual lines followed by an addition of all their expected lines.
+const maxDiffCells = 1 << 16
+
+// diffOp is a line of a diff: ' ' for a common line, '-' for a line
+// only in the actual text and '+' for a line only in the expected text.
+type diffOp struct {
+	kind byte
+	line string
+	a, b int // line numbers in the actual and expected texts, starting at 1
+}
+
+// Diff returns a unified diff of the lines of the actual and expected texts,
+// with lines only in the actual text prefixed by "-" and lines only in the
+// expected text prefixed by "+", keeping the given number of unchanged lines
+// of context around each change. It returns "" if the texts are equal or if
+// the context is negative.
+func Diff(actual, expected string, context int) string {
+	if context < 0 || actual == expected {
+		return ""
+	}
+	a, b := strings.Split(actual, "\n"), strings.Split(expected, "\n")
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// a hunk spans the changes separated by at most 2*context common lines
+		start, end := max(i-context, 0), i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*context {
+				end = min(end+context, len(ops))
+				break
+			}
+			end = next
+		}
+		writeHunk(&sb, ops[start:end])
+		i = end
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// diffLines returns the edit script from a to b. The common prefix and
+// suffix of the texts are kept, and the lines between them are diffed by
+// their longest common subsequence if its table fits in maxDiffCells.
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, diffOp{' ', a[i], i + 1, i + 1})
+	}
+	ops = append(ops, diffMiddle(a[:len(a)-suffix], b[:len(b)-suffix], prefix)...)
+	for k := suffix; k > 0; k-- {
+		i, j := len(a)-k, len(b)-k
+		ops = append(ops, diffOp{' ', a[i], i + 1, j + 1})
+	}
+	return ops
+}
+
+// diffMiddle returns the edit script from a[start:] to b[start:], which
+// neither start nor end with a common line.
+func diffMiddle(a, b []string, start int) []diffOp {
+	n, m := len(a)-start, len(b)-start
+	var ops []diffOp
+	if n*m > maxDiffCells {
+		for i := start; i < len(a); i++ {
+			ops = append(ops, diffOp{'-', a[i], i + 1, start})
+		}
+		for j := start; j < len(b); j++ {
+			ops = append(ops, diffOp{'+', b[j], len(a), j + 1})
+		}
+		return ops
+	}
+	// lcs[i*(m+1)+j] is the length of the longest common subsequence
+	// of a[start+i:] and b[start+j:]
+	lcs := make([]int32, (n+1)*(m+1))
+	at := func(i, j int) int32 { return lcs[i*(m+1)+j] }
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[start+i] == b[start+j] {
+				lcs[i*(m+1)+j] = at(i+1, j+1) + 1
+			} else {
+				lcs[i*(m+1)+j] = max(at(i+1, j), at(i, j+1))
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[start+i] == b[start+j]:
+			ops = append(ops, diffOp{' ', a[start+i], start + i + 1, start + j + 1})
+			i++
+			j++
+		case j == m || (i < n && at(i+1, j) >= at(i, j+1)):
+			ops = append(ops, diffOp{'-', a[start+i], start + i + 1, start + j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[start+j], start + i, start + j + 1})
+			j++
+		}
+	}
+	return ops
+}
+
+// writeHunk writes a hunk header followed by its lines.
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	var aStart, aLen, bStart, bLen int
+	for _, op := range ops {
+		if op.kind != '+' {
+			if aLen == 0 {
+				aStart = op.a
+			}
+			aLen++
+		}
+		if op.kind != '-' {
+			if bLen == 0 {
+				bStart = op.b
+			}
+			bLen++
+		}
+	}
+	if aLen == 0 {
+		aStart = ops[0].a
+	}
+	if bLen == 0 {
+		bStart = ops[0].b
+	}
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+	for _, op := range ops {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		sb.WriteByte('\n')
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// Detail is a named value of a failure, such as the pattern of a match.
+type Detail struct {
+	Name  string
+	Value string
+}
+
+// Location is the position of a failed assertion in the user code.
+type Location struct {
+	File     string
+	Line     int
+	Function string
+}
+
+// Failure describes a failed assertion.
+type Failure struct {
+	Assertion string   // name of the assertion, e.g. "NumberAssertion.Equal"
+	Summary   string   // one-line description of the failure
+	Actual    string   // formatted actual value, if any
+	Expected  string   // formatted expected value, if any
+	Details   []Detail // other named values, e.g. the pattern of a match
+	Diff      string   // difference between the actual and expected values, if any
+	Message   string   // user message, if any
+	Label     string   // label or source expression of the asserted value, if any
+	Context   []any    // key/value context of the assertion, if any
+	Location  Location // position of the assertion in the user code
+	Fatal     bool     // whether the test stops on this failure
+	Language  string   // language of the failure message, English if not set
+}
+
+// String renders the failure as plain text.
+func (f *Failure) String() string {
+	return f.Render(Style{})
+}
+
+// Reporter formats a failure and delivers it to the test context.
+type Reporter interface {
+	Report(t TestingT, f *Failure)
+}
+
+// ReporterFunc is an adapter to allow the use of ordinary functions as reporters.
+type ReporterFunc func(t TestingT, f *Failure)
+
+// Report calls fn(t, f).
+func (fn ReporterFunc) Report(t TestingT, f *Failure) {
+	t.Helper()
+	fn(t, f)
+}
+
+// TextReporter reports failures as text, in the style configured for
+// the test, see Config. It calls `t.Fatal` for fatal failures;
+// otherwise, it calls `t.Error`. If t supports `Logf`, the composite
+// values of the context are also logged in full at the first failure.
+type TextReporter struct{}
+
+// Report reports the failure as text.
+func (TextReporter) Report(t TestingT, f *Failure) {
+	t.Helper()
+	if !Failed(t) {
+		dumpContext(t, f)
+	}
+	str := Translate(f.Language, "failure.prefix") + f.Render(ConfigFor(t).Style)
+	if f.Fatal {
+		t.Fatal(str)
+	} else {
+		t.Error(str)
+	}
+}
+
+// dumpContext logs the structs, maps, slices and arrays of the context of
+// the failure in full, as the header of the failure only prints them with
+// %v. It's done at the first failure of the test only, since the following
+// failures usually share the same context.
+func dumpContext(t TestingT, f *Failure) {
+	t.Helper()
+	c := ConfigFor(t)
+	opts := c.PrettyOptions()
+	for i := 0; i+1 < len(f.Context); i += 2 {
+		switch reflect.Indirect(reflect.ValueOf(f.Context[i+1])).Kind() {
+		case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+			str := Pretty(f.Context[i+1], opts)
+			if !Logf(t, "%s", Translate(f.Language, "failure.context", f.Context[i], str)) {
+				return
+			}
+		}
+	}
+}
+
+// reporterHolder wraps a Reporter so it can be stored atomically.
+type reporterHolder struct {
+	Reporter
+}
+
+var reporter atomic.Pointer[reporterHolder]
+
+// SetReporter sets the reporter of assertion failures.
+// Passing nil restores the reporter selected by the environment variables.
+func SetReporter(r Reporter) {
+	if r == nil {
+		reporter.Store(nil)
+		return
+	}
+	reporter.Store(&reporterHolder{r})
+}
+
+// GetReporter returns the reporter of assertion failures. Unless set by
+// SetReporter, it's the reporter selected by the environment variables.
+func GetReporter() Reporter {
+	if h := reporter.Load(); h != nil {
+		return h.Reporter
+	}
+	return envReporter()
+}
+
+// ReporterFor returns the reporter of the failures of t: t itself if it is
+// a Reporter, like the checker of the `must` package, otherwise the current
+// reporter, see GetReporter.
+func ReporterFor(t TestingT) Reporter {
+	if r, ok := t.(Reporter); ok {
+		return r
+	}
+	return GetReporter()
+}
+
+// Locate returns the location of the first frame of the current call stack
+// outside this library and outside the functions of the assertion type
+// named typ, if any, i.e. the failed assertion.
+func Locate(typ string) Location {
+	frame, ok := Capture(1).Caller(typ)
+	if !ok {
+		return Location{}
+	}
+	return Location{
+		File:     frame.File,
+		Line:     frame.Line,
+		Function: frame.Function,
+	}
+}
+
+// AssertionName returns the name of the assertion method that is the
+// caller of the function calling AssertionName, e.g. "NumberAssertion.Equal"
+// for "github.com/go-spring/gs-assert/assert.(*NumberAssertion[...]).Equal".
+func AssertionName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 2)
+	if !ok {
+		return ""
+	}
+	name := runtime.FuncForPC(pc).Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = name[strings.Index(name, ".")+1:]
+	name = strings.NewReplacer("(*", "", ")", "", "[...]", "").Replace(name)
+	return name
+}
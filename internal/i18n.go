@@ -0,0 +1,122 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+)
+
+// EnvLanguage selects the language of failure messages, e.g. "en" (default) or "zh".
+const EnvLanguage = "GS_ASSERT_LANG"
+
+// Languages supported out of the box.
+const (
+	English = "en"
+	Chinese = "zh"
+)
+
+var (
+	catalogMu sync.RWMutex
+	catalogs  = map[string]map[string]string{
+		English: messagesEN,
+		Chinese: messagesZH,
+	}
+)
+
+// RegisterLanguage adds or extends the message catalogue of a language.
+// The messages map catalogue keys to format strings, which may use explicit
+// argument indexes, e.g. "%[2]v", when the word order of the language differs
+// from English. Keys missing in a catalogue fall back to English.
+func RegisterLanguage(lang string, messages map[string]string) {
+	lang = NormalizeLanguage(lang)
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	m := make(map[string]string, len(catalogs[lang])+len(messages))
+	maps.Copy(m, catalogs[lang])
+	maps.Copy(m, messages)
+	catalogs[lang] = m
+}
+
+// Catalog returns the message catalogue of the language, or nil if the
+// language is not registered.
+func Catalog(lang string) map[string]string {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	return catalogs[NormalizeLanguage(lang)]
+}
+
+// NormalizeLanguage reduces a language tag such as "zh-CN", "zh_CN.UTF-8"
+// or "ZH" to its lowercase primary subtag, e.g. "zh".
+func NormalizeLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_."); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+// SetLanguage sets the language of failure messages of all tests.
+// Passing "" restores the language selected by the environment variable.
+func SetLanguage(lang string) {
+	if lang == "" {
+		lang = envConfig().Language
+	}
+	Configure(func(c *Config) { c.Language = NormalizeLanguage(lang) })
+}
+
+// GetLanguage returns the language of failure messages of all tests.
+func GetLanguage() string {
+	return GlobalConfig().Language
+}
+
+// Translate returns the message of the key in the language, formatted with
+// the arguments. It falls back to English if the language or the key is not
+// in the catalogue, and to the key itself if English lacks it too.
+func Translate(lang, key string, args ...any) string {
+	format, ok := lookup(lang, key)
+	if !ok {
+		format = key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// lookup returns the format string of the key in the language,
+// falling back to English.
+func lookup(lang, key string) (string, bool) {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	if format, ok := catalogs[NormalizeLanguage(lang)][key]; ok {
+		return format, true
+	}
+	format, ok := catalogs[English][key]
+	return format, ok
+}
+
+// fieldName returns the localized name of a failure field, e.g. "actual",
+// or the name itself if the catalogue has no translation for it.
+func fieldName(lang, name string) string {
+	if s, ok := lookup(lang, "field."+name); ok {
+		return s
+	}
+	return name
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+// messagesEN is the English message catalogue, which is also the fallback
+// for keys missing in other catalogues.
+var messagesEN = map[string]string{
+	"failure.prefix":   "Assertion failed: ",
+	"failure.context":  "context %v: %s",
+	"field.actual":     "actual",
+	"field.expected":   "expected",
+	"field.diff":       "diff",
+	"field.message":    "message",
+	"field.error":      "error",
+	"field.pattern":    "pattern",
+	"field.prefix":     "prefix",
+	"field.suffix":     "suffix",
+	"field.sub":        "sub",
+	"field.elements":   "elements",
+	"field.abs_error":  "abs err",
+	"field.rel_error":  "rel err",
+	"field.ulps":       "ulps",
+	"field.statistic":  "stat",
+	"field.p_value":    "p-value",
+	"field.alpha":      "alpha",
+	"panic.no_panic":   "did not panic",
+	"pattern.invalid":  "invalid pattern",
+	"pattern.no_match": "got %q which does not match %q",
+
+	"value.true":                     "expected value to be true, but it is false",
+	"value.false":                    "expected value to be false, but it is true",
+	"value.nil":                      "expected value to be nil, but it is not",
+	"value.not_nil":                  "expected value to be non-nil, but it is nil",
+	"value.equal":                    "expected values to be equal, but they are different",
+	"value.not_equal":                "expected values to be different, but they are equal",
+	"value.same":                     "expected values to be same, but they are different",
+	"value.not_same":                 "expected values to be different, but they are same",
+	"value.type_of":                  "expected type to be assignable to target, but it does not",
+	"value.implements.not_interface": "expected target to implement should be interface",
+	"value.implements":               "expected type to implement target interface, but it does not",
+	"value.method.not_found":         "method '%s' not found on type %s",
+	"value.method.not_bool":          "method '%s' on type %s should return only a bool, but it does not",
+	"value.method.false":             "method '%s' on type %s should return true when using param %s, but it does not",
+	"value.field.not_found":          "field '%s' not found on type %s",
+	"value.field.nil":                "field '%s' not found on nil value of type %s",
+
+	"string.length":                    "expected string to have length %d, but it has length %d",
+	"string.blank":                     "expected string to contain only whitespace, but it does not",
+	"string.not_blank":                 "expected string to be non-blank, but it is blank",
+	"string.equal":                     "expected strings to be equal, but they are not",
+	"string.not_equal":                 "expected strings to be different, but they are equal",
+	"string.equal_fold":                "expected strings to be equal (case-insensitive), but they are not",
+	"string.json_equal.invalid_actual": "expected strings to be JSON-equal, but failed to unmarshal actual value",
+	"string.json_equal.invalid_expect": "expected strings to be JSON-equal, but failed to unmarshal expected value",
+	"string.json_equal":                "expected strings to be JSON-equal, but they are not",
+	"string.matches":                   "expected string to match the pattern, but it does not",
+	"string.has_prefix":                "expected string to start with the specified prefix, but it does not",
+	"string.has_suffix":                "expected string to end with the specified suffix, but it does not",
+	"string.contains":                  "expected string to contain the specified substring, but it does not",
+	"string.lower_case":                "expected string to be all lowercase, but it is not",
+	"string.upper_case":                "expected string to be all uppercase, but it is not",
+	"string.numeric":                   "expected string to contain only digits, but it does not",
+	"string.alpha":                     "expected string to contain only letters, but it does not",
+	"string.alpha_numeric":             "expected string to contain only letters and digits, but it does not",
+	"string.email":                     "expected string to be a valid email, but it is not",
+	"string.url":                       "expected string to be a valid URL, but it is not",
+	"string.ip":                        "expected string to be a valid IP, but it is not",
+	"string.hex":                       "expected string to be a valid hexadecimal, but it is not",
+	"string.base64":                    "expected string to be a valid Base64, but it is not",
+
+	"number.equal":            "expected number to be equal to %v, but it is %v",
+	"number.not_equal":        "expected number not to be equal to %v, but it is",
+	"number.greater_than":     "expected number to be greater than %v, but it is %v",
+	"number.greater_or_equal": "expected number to be greater than or equal to %v, but it is %v",
+	"number.less_than":        "expected number to be less than %v, but it is %v",
+	"number.less_or_equal":    "expected number to be less than or equal to %v, but it is %v",
+	"number.zero":             "expected number to be zero, but it is %v",
+	"number.not_zero":         "expected number not to be zero, but it is %v",
+	"number.positive":         "expected number to be positive, but it is %v",
+	"number.not_positive":     "expected number to be non-positive, but it is %v",
+	"number.negative":         "expected number to be negative, but it is %v",
+	"number.not_negative":     "expected number to be non-negative, but it is %v",
+	"number.between":          "expected number to be between %v and %v, but it is %v",
+	"number.not_between":      "expected number not to be between %v and %v, but it is %v",
+	"number.in_delta":         "expected number to be within ±%v of %v, but it is %v",
+	"number.in_epsilon":       "expected number to be within relative error %v of %v, but it is %v",
+	"number.within_ulps":      "expected number to be within %v ULPs of %v, but it is %v",
+	"number.approx_equal":     "expected number to be approximately equal to %v, but it is %v",
+	"number.not_number":       "cannot compare with %v of non-numeric type %T",
+	"number.nil":              "cannot compare nil numbers",
+	"number.nan":              "expected number to be NaN, but it is %v",
+	"number.inf":              "expected number to be %sInf, but it is %v",
+	"number.finite":           "expected number to be finite, but it is %v",
+
+	"complex.equal":     "expected complex number to be equal to %v, but it is %v",
+	"complex.not_equal": "expected complex number not to be equal to %v, but it is",
+	"complex.in_delta":  "expected complex number to be within ±%v of %v, but it is %v",
+	"complex.phase":     "expected phase to be within ±%v of %v, but it is %v",
+	"complex.nan":       "expected complex number to be NaN, but it is %v",
+	"complex.inf":       "expected complex number to be infinite, but it is %v",
+
+	"samples.too_few":           "expected at least %d samples, but got %d",
+	"samples.percentile":        "expected percentile to be between 0 and 100, but it is %v",
+	"samples.monotonic":         "expected samples to be monotonic, but the direction changes at index %d, from %v to %v",
+	"samples.uniform.invalid":   "invalid uniform distribution in [%v, %v) with %d bins",
+	"samples.uniform.range":     "expected samples to be in [%v, %v), but %v at index %d is not",
+	"samples.uniform":           "expected samples to be uniformly distributed, but the chi-square test rejects it",
+	"samples.same_distribution": "expected samples to have the same distribution, but the Kolmogorov-Smirnov test rejects it",
+
+	"vector.length":         "expected vector to have length %d, but it has length %d",
+	"vector.in_delta":       "expected vector elements to be within ±%v, but %d of %d elements are not",
+	"vector.in_epsilon":     "expected vector elements to be within relative error %v, but %d of %d elements are not",
+	"vector.cell.abs_error": "%v, expected %v (abs err %v)",
+	"vector.cell.rel_error": "%v, expected %v (rel err %v)",
+	"vector.more":           "and %d more elements",
+	"matrix.shape":          "expected matrix to have shape %dx%d, but it has shape %dx%d",
+	"matrix.jagged":         "expected matrix rows to have the same length, but row %d has length %d instead of %d",
+	"matrix.rows":           "expected matrix to have %d rows, but it has %d rows",
+	"matrix.row_length":     "expected row %d to have length %d, but it has length %d",
+	"matrix.square":         "expected matrix to be square, but it has shape %dx%d",
+	"matrix.in_delta":       "expected matrix elements to be within ±%v, but %d of %d elements are not",
+	"matrix.in_epsilon":     "expected matrix elements to be within relative error %v, but %d of %d elements are not",
+	"matrix.symmetric":      "expected matrix to be symmetric, but %d elements differ from their transposed elements",
+	"matrix.identity":       "expected matrix to be the identity, but %d of %d elements are not",
+
+	"ordered.equal":            "expected value to be equal to %s, but it is %s",
+	"ordered.not_equal":        "expected value not to be equal to %s, but it is",
+	"ordered.greater_than":     "expected value to be greater than %s, but it is %s",
+	"ordered.greater_or_equal": "expected value to be greater than or equal to %s, but it is %s",
+	"ordered.less_than":        "expected value to be less than %s, but it is %s",
+	"ordered.less_or_equal":    "expected value to be less than or equal to %s, but it is %s",
+	"ordered.between":          "expected value to be in [%s, %s], but it is %s",
+	"ordered.strictly_between": "expected value to be in (%s, %s), but it is %s",
+	"ordered.in_range":         "expected value to be in [%s, %s), but it is %s",
+	"ordered.not_between":      "expected value not to be in [%s, %s], but it is %s",
+
+	"slice.length":                    "expected slice to have length %d, but it has length %d",
+	"slice.element":                   "expected slice to have an element at index %d, but it has length %d",
+	"slice.nil":                       "expected slice to be nil, but it is not",
+	"slice.not_nil":                   "expected slice not to be nil, but it is",
+	"slice.empty":                     "expected slice to be empty, but it is not",
+	"slice.not_empty":                 "expected slice not to be empty, but it is",
+	"slice.equal.length":              "expected slices to be equal, but their lengths are different",
+	"slice.equal.index":               "expected slices to be equal, but values at index %d are different",
+	"slice.not_equal":                 "expected slices to be different, but they are equal",
+	"slice.contains":                  "expected slice to contain element %s, but it is missing",
+	"slice.not_contains":              "expected slice not to contain element %+v, but it is found",
+	"slice.contains_slice":            "expected slice to contain sub-slice, but it is not",
+	"slice.not_contains_slice":        "expected slice not to contain sub-slice, but it is",
+	"slice.subsequence.first":         "expected slice to contain the subsequence, but its element 0 (%s) is missing",
+	"slice.subsequence":               "expected slice to contain the subsequence, but its element %d (%s) is missing after its element %d matched at index %d",
+	"slice.appears_before":            "expected %s to appear before %s, but they are at index %d and %d",
+	"slice.appears_before.missing":    "expected %s to appear before %s, but %s is missing",
+	"slice.contains_all":              "expected slice to contain all the elements, but %s are missing",
+	"slice.contains_any":              "expected slice to contain any of the elements, but it contains none",
+	"slice.contains_none":             "expected slice to contain none of the elements, but %s is found at index %d",
+	"slice.contains_only":             "expected slice to contain only the elements, but %s at index %d is not one of them",
+	"slice.has_prefix":                "expected slice to start with prefix, but it is not",
+	"slice.has_suffix":                "expected slice to end with suffix, but it is not",
+	"slice.all_unique":                "expected all elements in the slice to be unique, but duplicates are found",
+	"slice.unique_by":                 "expected the keys of all elements in the slice to be unique, but duplicates are found",
+	"slice.duplicates.more":           "and %d more duplicates",
+	"slice.all_match":                 "expected all elements in the slice to satisfy the condition, but element %s does not",
+	"slice.any_match":                 "expected at least one element in the slice to satisfy the condition, but none do",
+	"slice.none_match":                "expected no element in the slice to satisfy the condition, but element %s does",
+	"slice.not_ordered":               "expected slice elements to be ordered, but type %s is not, use IsSortedBy",
+	"slice.nan":                       "expected slice elements to be ordered, but element %d is NaN, which is unordered",
+	"slice.sorted":                    "expected slice to be sorted, but element %[1]d (%[2]s) is greater than element %[3]d (%[4]s)",
+	"slice.strictly_sorted":           "expected slice to be strictly sorted, but element %[1]d (%[2]s) is not less than element %[3]d (%[4]s)",
+	"slice.sorted_desc":               "expected slice to be sorted in descending order, but element %[1]d (%[2]s) is less than element %[3]d (%[4]s)",
+	"slice.extracting.nil":            "cannot extract %[1]s from element %[2]d, as %[3]s is nil",
+	"slice.extracting.nil_element":    "cannot extract %s from element %d, as it is nil",
+	"slice.extracting.no_field":       "cannot extract %[1]s from element %[2]d, as type %[3]s has no exported field %[4]s",
+	"slice.extracting.not_comparable": "cannot extract %[1]s from element %[2]d, as type %[3]s is not comparable",
+
+	"map.length":                "expected map to have length %d, but it has length %d",
+	"map.nil":                   "expected map to be nil, but it is not",
+	"map.not_nil":               "expected map not to be nil, but it is",
+	"map.empty":                 "expected map to be empty, but it is not",
+	"map.not_empty":             "expected map to be non-empty, but it is empty",
+	"map.equal.length":          "expected maps to be equal, but their lengths are different",
+	"map.equal.missing_key":     "expected maps to be equal, but key '%v' is missing",
+	"map.equal.value":           "expected maps to be equal, but values for key '%v' are different",
+	"map.not_equal":             "expected maps to be different, but they are equal",
+	"map.contains_key":          "expected map to contain key '%v', but it is missing",
+	"map.not_contains_key":      "expected map not to contain key '%v', but it is found",
+	"map.contains_value":        "expected map to contain value %+v, but it is missing",
+	"map.not_contains_value":    "expected map not to contain value %+v, but it is found",
+	"map.key_value":             "expected value %+v for key '%v', but got %+v instead",
+	"map.subset.unexpected_key": "expected map to be a subset, but unexpected key '%v' is found",
+	"map.subset.value":          "expected map to be a subset, but values for key '%v' are different",
+	"map.superset.missing_key":  "expected map to be a superset, but key '%v' is missing",
+	"map.superset.value":        "expected map to be a superset, but values for key '%v' are different",
+	"map.same_keys.length":      "expected maps to have the same keys, but their lengths are different",
+	"map.same_keys.missing_key": "expected maps to have the same keys, but key '%v' is missing",
+	"map.same_values.length":    "expected maps to have the same values, but their lengths are different",
+	"map.same_values":           "expected maps to have the same values, but their values are different",
+
+	"error.nil":         "expected error to be nil, but it is not",
+	"error.not_nil":     "expected error to be non-nil, but it is nil",
+	"error.is":          "expected error to be target (according to errors.Is), but they are different",
+	"error.not_is":      "expected error not to be target (according to errors.Is), but they are equal",
+	"error.matches.nil": "expected non-nil error, but got nil",
+
+	"recorder.succeeded": "expected the test to succeed, but it failed",
+	"recorder.failed":    "expected the test to fail, but it did not",
+	"recorder.halted":    "expected the test to be stopped by Fatal, but it was not",
+	"recorder.skipped":   "expected the test to be skipped, but it was not",
+	"recorder.reported":  "expected a %s message matching the pattern, but there is none",
+	"recorder.helper":    "expected Helper to be called, but it was not",
+}
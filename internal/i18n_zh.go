@@ -0,0 +1,224 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+// messagesZH is the Simplified Chinese message catalogue.
+var messagesZH = map[string]string{
+	"failure.prefix":   "断言失败: ",
+	"failure.context":  "上下文 %v: %s",
+	"field.actual":     "实际值",
+	"field.expected":   "期望值",
+	"field.diff":       "差异",
+	"field.message":    "信息",
+	"field.error":      "错误",
+	"field.pattern":    "模式",
+	"field.prefix":     "前缀",
+	"field.suffix":     "后缀",
+	"field.sub":        "子序列",
+	"field.elements":   "元素",
+	"field.abs_error":  "绝对误差",
+	"field.rel_error":  "相对误差",
+	"field.ulps":       "ULP 距离",
+	"field.statistic":  "统计量",
+	"field.p_value":    "p 值",
+	"field.alpha":      "显著性",
+	"panic.no_panic":   "没有发生 panic",
+	"pattern.invalid":  "无效的模式",
+	"pattern.no_match": "得到 %q，与模式 %q 不匹配",
+
+	"value.true":                     "期望值为 true，但实际为 false",
+	"value.false":                    "期望值为 false，但实际为 true",
+	"value.nil":                      "期望值为 nil，但实际不是",
+	"value.not_nil":                  "期望值不为 nil，但实际为 nil",
+	"value.equal":                    "期望两个值相等，但实际不同",
+	"value.not_equal":                "期望两个值不同，但实际相等",
+	"value.same":                     "期望两个值相同，但实际不同",
+	"value.not_same":                 "期望两个值不同，但实际相同",
+	"value.type_of":                  "期望类型可以赋值给目标类型，但实际不可以",
+	"value.implements.not_interface": "期望实现的目标应为接口类型",
+	"value.implements":               "期望类型实现目标接口，但实际没有实现",
+	"value.method.not_found":         "类型 %[2]s 上没有方法 '%[1]s'",
+	"value.method.not_bool":          "类型 %[2]s 上的方法 '%[1]s' 应只返回一个 bool 值，但实际不是",
+	"value.method.false":             "类型 %[2]s 上的方法 '%[1]s' 在参数为 %[3]s 时应返回 true，但实际不是",
+	"value.field.not_found":          "类型 %[2]s 上没有字段 '%[1]s'",
+	"value.field.nil":                "类型 %[2]s 的 nil 值上没有字段 '%[1]s'",
+
+	"string.length":                    "期望字符串长度为 %d，但实际长度为 %d",
+	"string.blank":                     "期望字符串只包含空白字符，但实际不是",
+	"string.not_blank":                 "期望字符串不为空白，但实际为空白",
+	"string.equal":                     "期望字符串相等，但实际不相等",
+	"string.not_equal":                 "期望字符串不同，但实际相等",
+	"string.equal_fold":                "期望字符串相等（忽略大小写），但实际不相等",
+	"string.json_equal.invalid_actual": "期望字符串的 JSON 相等，但无法解析实际值",
+	"string.json_equal.invalid_expect": "期望字符串的 JSON 相等，但无法解析期望值",
+	"string.json_equal":                "期望字符串的 JSON 相等，但实际不相等",
+	"string.matches":                   "期望字符串匹配模式，但实际不匹配",
+	"string.has_prefix":                "期望字符串以指定前缀开头，但实际不是",
+	"string.has_suffix":                "期望字符串以指定后缀结尾，但实际不是",
+	"string.contains":                  "期望字符串包含指定子串，但实际不包含",
+	"string.lower_case":                "期望字符串全部为小写，但实际不是",
+	"string.upper_case":                "期望字符串全部为大写，但实际不是",
+	"string.numeric":                   "期望字符串只包含数字，但实际不是",
+	"string.alpha":                     "期望字符串只包含字母，但实际不是",
+	"string.alpha_numeric":             "期望字符串只包含字母和数字，但实际不是",
+	"string.email":                     "期望字符串是有效的邮箱地址，但实际不是",
+	"string.url":                       "期望字符串是有效的 URL，但实际不是",
+	"string.ip":                        "期望字符串是有效的 IP 地址，但实际不是",
+	"string.hex":                       "期望字符串是有效的十六进制数，但实际不是",
+	"string.base64":                    "期望字符串是有效的 Base64 编码，但实际不是",
+
+	"number.equal":            "期望数字等于 %v，但实际为 %v",
+	"number.not_equal":        "期望数字不等于 %v，但实际相等",
+	"number.greater_than":     "期望数字大于 %v，但实际为 %v",
+	"number.greater_or_equal": "期望数字大于或等于 %v，但实际为 %v",
+	"number.less_than":        "期望数字小于 %v，但实际为 %v",
+	"number.less_or_equal":    "期望数字小于或等于 %v，但实际为 %v",
+	"number.zero":             "期望数字为零，但实际为 %v",
+	"number.not_zero":         "期望数字不为零，但实际为 %v",
+	"number.positive":         "期望数字为正数，但实际为 %v",
+	"number.not_positive":     "期望数字不为正数，但实际为 %v",
+	"number.negative":         "期望数字为负数，但实际为 %v",
+	"number.not_negative":     "期望数字不为负数，但实际为 %v",
+	"number.between":          "期望数字在 %v 和 %v 之间，但实际为 %v",
+	"number.not_between":      "期望数字不在 %v 和 %v 之间，但实际为 %v",
+	"number.in_delta":         "期望数字在 %[2]v 的 ±%[1]v 范围内，但实际为 %[3]v",
+	"number.in_epsilon":       "期望数字与 %[2]v 的相对误差不超过 %[1]v，但实际为 %[3]v",
+	"number.within_ulps":      "期望数字与 %[2]v 相差不超过 %[1]v 个 ULP，但实际为 %[3]v",
+	"number.approx_equal":     "期望数字约等于 %v，但实际为 %v",
+	"number.not_number":       "无法与非数值类型 %[2]T 的值 %[1]v 比较",
+	"number.nil":              "无法比较为 nil 的数字",
+	"number.nan":              "期望数字为 NaN，但实际为 %v",
+	"number.inf":              "期望数字为 %sInf，但实际为 %v",
+	"number.finite":           "期望数字为有限值，但实际为 %v",
+
+	"complex.equal":     "期望复数等于 %v，但实际为 %v",
+	"complex.not_equal": "期望复数不等于 %v，但实际相等",
+	"complex.in_delta":  "期望复数在 %[2]v 的 ±%[1]v 范围内，但实际为 %[3]v",
+	"complex.phase":     "期望辐角在 %[2]v 的 ±%[1]v 范围内，但实际为 %[3]v",
+	"complex.nan":       "期望复数为 NaN，但实际为 %v",
+	"complex.inf":       "期望复数为无穷大，但实际为 %v",
+
+	"samples.too_few":           "期望至少有 %d 个样本，但实际只有 %d 个",
+	"samples.percentile":        "期望百分位在 0 到 100 之间，但实际为 %v",
+	"samples.monotonic":         "期望样本单调，但在索引 %d 处方向改变，从 %v 变为 %v",
+	"samples.uniform.invalid":   "无效的均匀分布：区间 [%v, %v)，%d 个分组",
+	"samples.uniform.range":     "期望样本在 [%[1]v, %[2]v) 范围内，但索引 %[4]d 处的 %[3]v 不在范围内",
+	"samples.uniform":           "期望样本服从均匀分布，但卡方检验拒绝了该假设",
+	"samples.same_distribution": "期望样本服从相同分布，但 Kolmogorov-Smirnov 检验拒绝了该假设",
+
+	"vector.length":         "期望向量长度为 %d，但实际长度为 %d",
+	"vector.in_delta":       "期望向量元素在 ±%v 范围内，但 %[3]d 个元素中有 %[2]d 个不在范围内",
+	"vector.in_epsilon":     "期望向量元素的相对误差不超过 %v，但 %[3]d 个元素中有 %[2]d 个超出",
+	"vector.cell.abs_error": "%v，期望 %v（绝对误差 %v）",
+	"vector.cell.rel_error": "%v，期望 %v（相对误差 %v）",
+	"vector.more":           "还有 %d 个元素",
+	"matrix.shape":          "期望矩阵形状为 %dx%d，但实际为 %dx%d",
+	"matrix.jagged":         "期望矩阵各行长度相同，但第 %d 行长度为 %d 而不是 %d",
+	"matrix.rows":           "期望矩阵有 %d 行，但实际有 %d 行",
+	"matrix.row_length":     "期望第 %d 行长度为 %d，但实际长度为 %d",
+	"matrix.square":         "期望矩阵为方阵，但实际形状为 %dx%d",
+	"matrix.in_delta":       "期望矩阵元素在 ±%v 范围内，但 %[3]d 个元素中有 %[2]d 个不在范围内",
+	"matrix.in_epsilon":     "期望矩阵元素的相对误差不超过 %v，但 %[3]d 个元素中有 %[2]d 个超出",
+	"matrix.symmetric":      "期望矩阵对称，但有 %d 个元素与其转置位置的元素不同",
+	"matrix.identity":       "期望矩阵为单位矩阵，但 %[2]d 个元素中有 %[1]d 个不符",
+
+	"ordered.equal":            "期望值等于 %s，但实际为 %s",
+	"ordered.not_equal":        "期望值不等于 %s，但实际相等",
+	"ordered.greater_than":     "期望值大于 %s，但实际为 %s",
+	"ordered.greater_or_equal": "期望值大于或等于 %s，但实际为 %s",
+	"ordered.less_than":        "期望值小于 %s，但实际为 %s",
+	"ordered.less_or_equal":    "期望值小于或等于 %s，但实际为 %s",
+	"ordered.between":          "期望值在 [%s, %s] 范围内，但实际为 %s",
+	"ordered.strictly_between": "期望值在 (%s, %s) 范围内，但实际为 %s",
+	"ordered.in_range":         "期望值在 [%s, %s) 范围内，但实际为 %s",
+	"ordered.not_between":      "期望值不在 [%s, %s] 范围内，但实际为 %s",
+
+	"slice.length":                    "期望切片长度为 %d，但实际长度为 %d",
+	"slice.element":                   "期望切片在索引 %d 处有元素，但实际长度为 %d",
+	"slice.nil":                       "期望切片为 nil，但实际不是",
+	"slice.not_nil":                   "期望切片不为 nil，但实际为 nil",
+	"slice.empty":                     "期望切片为空，但实际不为空",
+	"slice.not_empty":                 "期望切片不为空，但实际为空",
+	"slice.equal.length":              "期望切片相等，但长度不同",
+	"slice.equal.index":               "期望切片相等，但索引 %d 处的值不同",
+	"slice.not_equal":                 "期望切片不同，但实际相等",
+	"slice.contains":                  "期望切片包含元素 %s，但实际不包含",
+	"slice.not_contains":              "期望切片不包含元素 %+v，但实际包含",
+	"slice.contains_slice":            "期望切片包含子切片，但实际不包含",
+	"slice.not_contains_slice":        "期望切片不包含子切片，但实际包含",
+	"slice.subsequence.first":         "期望切片包含子序列，但缺少其元素 0（%s）",
+	"slice.subsequence":               "期望切片包含子序列，但其元素 %[3]d 在索引 %[4]d 处匹配后，缺少其元素 %[1]d（%[2]s）",
+	"slice.appears_before":            "期望 %s 出现在 %s 之前，但它们分别位于索引 %d 和 %d",
+	"slice.appears_before.missing":    "期望 %s 出现在 %s 之前，但缺少 %s",
+	"slice.contains_all":              "期望切片包含所有元素，但缺少 %s",
+	"slice.contains_any":              "期望切片包含任意一个元素，但一个都不包含",
+	"slice.contains_none":             "期望切片不包含任何元素，但在索引 %[2]d 处发现 %[1]s",
+	"slice.contains_only":             "期望切片只包含指定元素，但索引 %[2]d 处的 %[1]s 不在其中",
+	"slice.has_prefix":                "期望切片以指定前缀开头，但实际不是",
+	"slice.has_suffix":                "期望切片以指定后缀结尾，但实际不是",
+	"slice.all_unique":                "期望切片中的元素互不相同，但发现重复元素",
+	"slice.unique_by":                 "期望切片中元素的键互不相同，但发现重复的键",
+	"slice.duplicates.more":           "还有 %d 个重复项",
+	"slice.all_match":                 "期望切片中的所有元素满足条件，但元素 %s 不满足",
+	"slice.any_match":                 "期望切片中至少有一个元素满足条件，但没有元素满足",
+	"slice.none_match":                "期望切片中没有元素满足条件，但元素 %s 满足",
+	"slice.not_ordered":               "期望切片元素可排序，但类型 %s 不可排序，请使用 IsSortedBy",
+	"slice.nan":                       "期望切片元素可排序，但元素 %d 为 NaN，无法比较大小",
+	"slice.sorted":                    "期望切片有序，但元素 %[1]d（%[2]s）大于元素 %[3]d（%[4]s）",
+	"slice.strictly_sorted":           "期望切片严格有序，但元素 %[1]d（%[2]s）不小于元素 %[3]d（%[4]s）",
+	"slice.sorted_desc":               "期望切片降序排列，但元素 %[1]d（%[2]s）小于元素 %[3]d（%[4]s）",
+	"slice.extracting.nil":            "无法从元素 %[2]d 中提取 %[1]s，因为 %[3]s 为 nil",
+	"slice.extracting.nil_element":    "无法从元素 %[2]d 中提取 %[1]s，因为该元素为 nil",
+	"slice.extracting.no_field":       "无法从元素 %[2]d 中提取 %[1]s，因为类型 %[3]s 没有导出字段 %[4]s",
+	"slice.extracting.not_comparable": "无法从元素 %[2]d 中提取 %[1]s，因为类型 %[3]s 不可比较",
+
+	"map.length":                "期望映射长度为 %d，但实际长度为 %d",
+	"map.nil":                   "期望映射为 nil，但实际不是",
+	"map.not_nil":               "期望映射不为 nil，但实际为 nil",
+	"map.empty":                 "期望映射为空，但实际不为空",
+	"map.not_empty":             "期望映射不为空，但实际为空",
+	"map.equal.length":          "期望映射相等，但长度不同",
+	"map.equal.missing_key":     "期望映射相等，但缺少键 '%v'",
+	"map.equal.value":           "期望映射相等，但键 '%v' 的值不同",
+	"map.not_equal":             "期望映射不同，但实际相等",
+	"map.contains_key":          "期望映射包含键 '%v'，但实际不包含",
+	"map.not_contains_key":      "期望映射不包含键 '%v'，但实际包含",
+	"map.contains_value":        "期望映射包含值 %+v，但实际不包含",
+	"map.not_contains_value":    "期望映射不包含值 %+v，但实际包含",
+	"map.key_value":             "期望键 '%[2]v' 的值为 %+[1]v，但实际为 %+[3]v",
+	"map.subset.unexpected_key": "期望映射是子集，但发现多余的键 '%v'",
+	"map.subset.value":          "期望映射是子集，但键 '%v' 的值不同",
+	"map.superset.missing_key":  "期望映射是超集，但缺少键 '%v'",
+	"map.superset.value":        "期望映射是超集，但键 '%v' 的值不同",
+	"map.same_keys.length":      "期望映射的键相同，但长度不同",
+	"map.same_keys.missing_key": "期望映射的键相同，但缺少键 '%v'",
+	"map.same_values.length":    "期望映射的值相同，但长度不同",
+	"map.same_values":           "期望映射的值相同，但实际不同",
+
+	"error.nil":         "期望错误为 nil，但实际不是",
+	"error.not_nil":     "期望错误不为 nil，但实际为 nil",
+	"error.is":          "期望错误为目标错误（按 errors.Is 判断），但实际不是",
+	"error.not_is":      "期望错误不为目标错误（按 errors.Is 判断），但实际是",
+	"error.matches.nil": "期望错误不为 nil，但实际为 nil",
+
+	"recorder.succeeded": "期望测试成功，但实际失败",
+	"recorder.failed":    "期望测试失败，但实际没有失败",
+	"recorder.halted":    "期望测试被 Fatal 终止，但实际没有",
+	"recorder.skipped":   "期望测试被跳过，但实际没有",
+	"recorder.reported":  "期望存在与模式匹配的 %s 消息，但实际没有",
+	"recorder.helper":    "期望调用 Helper，但实际没有调用",
+}
@@ -19,12 +19,16 @@ package internal
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
 // TestingT is the minimum interface of *testing.T.
 // It provides basic methods for reporting test errors or failures.
+// Other methods of *testing.T, such as Cleanup, Name, Logf and
+// Failed, are used when available, see Cleanup.
 type TestingT interface {
 	Helper()
 	Error(args ...any)
@@ -65,18 +69,149 @@ func (m *MockTestingT) String() string {
 	return m.buf.String()
 }
 
-// Fail reports an assertion failure using the provided TestingT.
-// If fatalOnFailure is true, it calls `t.Fatal`; otherwise, it calls `t.Error`.
-func Fail(t TestingT, fatalOnFailure bool, str string, msg ...string) {
-	t.Helper()
-	if len(msg) > 0 {
-		str += fmt.Sprintf("\n message: %q", strings.Join(msg, ", "))
+// PinTestEnv sets the environment variables of the configuration and the
+// reporters to their defaults, i.e. English messages without colors in the
+// stacked layout, reported as text only, so that the failure messages checked
+// by tests don't depend on the environment they run in. It must be called
+// before the first assertion, e.g. in TestMain.
+func PinTestEnv() {
+	os.Setenv(EnvLanguage, English)
+	os.Setenv(EnvColor, "never")
+	os.Setenv(EnvLayout, "stacked")
+	for _, name := range []string{
+		EnvDiffContext, EnvMaxDepth, EnvMaxElements, EnvMaxString, EnvFloatTolerance,
+		EnvReporter, EnvReportDir, "COLUMNS",
+	} {
+		os.Unsetenv(name)
 	}
-	if fatalOnFailure {
-		t.Fatal("Assertion failed: " + str)
-	} else {
-		t.Error("Assertion failed: " + str)
+}
+
+// Message formats the optional user message arguments of an assertion.
+// The first argument may be a format string followed by its arguments,
+// e.g. ("user %d in tenant %s", id, tenant). Arguments of type func() string
+// are evaluated lazily, only when the message is actually needed.
+// The first argument is only used as a format string if its verbs consume
+// exactly the remaining arguments, otherwise all arguments are joined with
+// ", " to keep the behavior of plain string messages, e.g. ("100% sure", x).
+func Message(msg ...any) string {
+	if len(msg) == 0 {
+		return ""
+	}
+	args := make([]any, len(msg))
+	for i, arg := range msg {
+		if fn, ok := arg.(func() string); ok {
+			arg = fn()
+		}
+		args[i] = arg
+	}
+	if format, ok := args[0].(string); ok && len(args) > 1 && verbArgs(format) == len(args)-1 {
+		return fmt.Sprintf(format, args[1:]...)
+	}
+	var sb strings.Builder
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprint(arg))
 	}
+	return sb.String()
+}
+
+// verbArgs returns the number of arguments consumed by the formatting verbs
+// of format, or -1 if format is not a valid format string. A '%' followed by
+// a space is taken literally, as in "100% sure", so the space flag is not
+// supported.
+func verbArgs(format string) int {
+	n, argNum := 0, 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		if i++; i < len(format) && format[i] == ' ' {
+			continue
+		}
+		for ; i < len(format) && strings.IndexByte("+-#0123456789.*[]", format[i]) >= 0; i++ {
+			switch format[i] {
+			case '*':
+				argNum++
+				n = max(n, argNum)
+			case '[':
+				j := strings.IndexByte(format[i:], ']')
+				if j < 0 {
+					return -1
+				}
+				k, err := strconv.Atoi(format[i+1 : i+j])
+				if err != nil || k < 1 {
+					return -1
+				}
+				argNum = k - 1
+				i += j
+			}
+		}
+		if i == len(format) {
+			return -1
+		}
+		if format[i] == '%' {
+			continue
+		}
+		if strings.IndexByte("vTtbcdoOqxXUeEfFgGsp", format[i]) < 0 {
+			return -1
+		}
+		argNum++
+		n = max(n, argNum)
+	}
+	return n
+}
+
+// JoinLabel extends the label of a parent assertion with the label of a
+// nested value, e.g. a field (".ID") or an element ("[3]") of the parent.
+func JoinLabel(parent, child string) string {
+	if parent == "" {
+		return strings.TrimPrefix(child, ".")
+	}
+	if child == "" || strings.HasPrefix(child, ".") || strings.HasPrefix(child, "[") {
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+// Header renders the label and the key/value context of an assertion
+// as the header of a failure description, e.g. "order.total [tenant=acme]: ".
+// It returns an empty string if neither the label nor the context is set.
+func Header(label string, context []any) string {
+	if label == "" && len(context) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(label)
+	if len(context) > 0 {
+		if label != "" {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("[")
+		for i := 0; i < len(context); i += 2 {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if i+1 < len(context) {
+				fmt.Fprintf(&sb, "%v=%v", context[i], context[i+1])
+			} else {
+				fmt.Fprintf(&sb, "!BADKEY=%v", context[i])
+			}
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString(": ")
+	return sb.String()
+}
+
+// Fail reports an assertion failure using the Reporter of t, see ReporterFor.
+// The user message and the location of the assertion are filled in here.
+func Fail(t TestingT, f *Failure, msg ...any) {
+	t.Helper()
+	f.Message = Message(msg...)
+	f.Location = Locate("")
+	ReporterFor(t).Report(t, f)
 }
 
 // recovery executes the given function and recovers from any panic.
@@ -93,16 +228,31 @@ func recovery(fn func()) (str string) {
 
 // Panic asserts that fn panics and the panic message matches expr.
 // It reports an error if fn does not panic or if the recovered message does not satisfy expr.
-func Panic(t TestingT, fatalOnFailure bool, fn func(), expr string, msg ...string) {
+func Panic(t TestingT, fatalOnFailure bool, fn func(), expr string, msg ...any) {
 	t.Helper()
+	lang := ConfigFor(t).Language
 	if got := recovery(fn); got == "<<SUCCESS>>" {
-		Fail(t, fatalOnFailure, "did not panic", msg...)
+		Fail(t, &Failure{
+			Assertion: "Panic",
+			Summary:   Translate(lang, "panic.no_panic"),
+			Fatal:     fatalOnFailure,
+			Language:  lang,
+		}, msg...)
 	} else {
 		if ok, err := regexp.MatchString(expr, got); err != nil {
-			Fail(t, fatalOnFailure, "invalid pattern", msg...)
+			Fail(t, &Failure{
+				Assertion: "Panic",
+				Summary:   Translate(lang, "pattern.invalid"),
+				Fatal:     fatalOnFailure,
+				Language:  lang,
+			}, msg...)
 		} else if !ok {
-			str := fmt.Sprintf("got %q which does not match %q", got, expr)
-			Fail(t, fatalOnFailure, str, msg...)
+			Fail(t, &Failure{
+				Assertion: "Panic",
+				Summary:   Translate(lang, "pattern.no_match", got, expr),
+				Fatal:     fatalOnFailure,
+				Language:  lang,
+			}, msg...)
 		}
 	}
 }
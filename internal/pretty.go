@@ -0,0 +1,270 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PrettyOptions limits the output of Pretty.
+type PrettyOptions struct {
+	MaxDepth    int // depth of nested values printed before eliding them as {...}
+	MaxElements int // elements of a slice, array, map or struct printed before "... N more"
+	MaxString   int // bytes of a string printed before "... N more"
+	Width       int // width of a single-line value before it is printed on multiple lines
+}
+
+// Pretty formats a value for failure messages. Unlike `%#v`, it follows
+// pointers instead of printing addresses, detects cycles, sorts map keys,
+// elides values beyond the limits, and prints large values on multiple
+// indented lines. Type names are omitted, as failures print them separately.
+func Pretty(v any, opts PrettyOptions) string {
+	p := &printer{opts: opts, visiting: map[visit]bool{}}
+	return p.format(reflect.ValueOf(v), 0)
+}
+
+// visit identifies a reference value on the path being printed.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type printer struct {
+	opts     PrettyOptions
+	visiting map[visit]bool
+}
+
+// format formats the value at the given nesting depth.
+func (p *printer) format(v reflect.Value, depth int) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return formatFloat(v.Float(), v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprint(v.Complex())
+	case reflect.String:
+		return p.formatString(v.String())
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("(%#x)", v.Pointer())
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+		return p.format(v.Elem(), depth)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "nil"
+		}
+		return p.enter(v, depth, func() string {
+			return p.format(v.Elem(), depth)
+		})
+	case reflect.Map:
+		if v.IsNil() {
+			return "nil"
+		}
+		return p.enter(v, depth, func() string {
+			return p.formatMap(v, depth)
+		})
+	case reflect.Slice:
+		if v.IsNil() {
+			return "nil"
+		}
+		return p.enter(v, depth, func() string {
+			return p.formatList(v, depth)
+		})
+	case reflect.Array:
+		return p.formatList(v, depth)
+	case reflect.Struct:
+		return p.formatStruct(v, depth)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// enter formats a reference value with fn, unless the value is already
+// being printed by an enclosing call, in which case it prints <cycle>.
+func (p *printer) enter(v reflect.Value, depth int, fn func() string) string {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if p.visiting[key] {
+		return "<cycle>"
+	}
+	p.visiting[key] = true
+	defer delete(p.visiting, key)
+	return fn()
+}
+
+// formatFloat formats a float in the shortest form that reads back exactly.
+func formatFloat(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// formatString quotes the string, truncated to the maximum length.
+func (p *printer) formatString(s string) string {
+	if p.opts.MaxString <= 0 || len(s) <= p.opts.MaxString {
+		return strconv.Quote(s)
+	}
+	n := p.opts.MaxString
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return fmt.Sprintf("%s... %d more", strconv.Quote(s[:n]), len(s)-n)
+}
+
+// formatList formats the elements of a slice or an array.
+func (p *printer) formatList(v reflect.Value, depth int) string {
+	if p.tooDeep(depth) {
+		return "{...}"
+	}
+	n := p.limit(v.Len())
+	items := make([]string, 0, n+1)
+	for i := range n {
+		items = append(items, p.format(v.Index(i), depth+1))
+	}
+	return p.join(items, v.Len()-n, depth)
+}
+
+// formatMap formats the entries of a map, sorted by key.
+func (p *printer) formatMap(v reflect.Value, depth int) string {
+	if p.tooDeep(depth) {
+		return "{...}"
+	}
+	keys := v.MapKeys()
+	sortKeys(keys)
+	n := p.limit(len(keys))
+	items := make([]string, 0, n+1)
+	for _, k := range keys[:n] {
+		items = append(items, p.format(k, depth+1)+":"+p.format(v.MapIndex(k), depth+1))
+	}
+	return p.join(items, len(keys)-n, depth)
+}
+
+// formatStruct formats the fields of a struct, including unexported ones.
+func (p *printer) formatStruct(v reflect.Value, depth int) string {
+	if p.tooDeep(depth) {
+		return "{...}"
+	}
+	t := v.Type()
+	n := p.limit(t.NumField())
+	items := make([]string, 0, n+1)
+	for i := range n {
+		items = append(items, t.Field(i).Name+":"+p.format(v.Field(i), depth+1))
+	}
+	return p.join(items, t.NumField()-n, depth)
+}
+
+// tooDeep reports whether values at the depth are beyond the depth limit.
+func (p *printer) tooDeep(depth int) bool {
+	return p.opts.MaxDepth > 0 && depth >= p.opts.MaxDepth
+}
+
+// limit returns how many of the n elements are printed.
+func (p *printer) limit(n int) int {
+	if p.opts.MaxElements > 0 && n > p.opts.MaxElements {
+		return p.opts.MaxElements
+	}
+	return n
+}
+
+// join encloses the items in braces, on a single line if it's short enough,
+// otherwise one item per line, indented according to the depth.
+func (p *printer) join(items []string, more int, depth int) string {
+	if more > 0 {
+		items = append(items, fmt.Sprintf("... %d more", more))
+	}
+	line := "{" + strings.Join(items, ", ") + "}"
+	indent := 2 * depth
+	if !strings.Contains(line, "\n") && (p.opts.Width <= 0 || indent+len(line) <= p.opts.Width) {
+		return line
+	}
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	prefix := strings.Repeat("  ", depth+1)
+	for _, item := range items {
+		sb.WriteString(prefix)
+		sb.WriteString(item)
+		sb.WriteString(",\n")
+	}
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// sortKeys sorts map keys: numbers, strings and booleans by value,
+// other keys by their formatted text.
+func sortKeys(keys []reflect.Value) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return compareKeys(keys[i], keys[j]) < 0
+	})
+}
+
+// compareKeys compares two map keys of the same type.
+func compareKeys(a, b reflect.Value) int {
+	if a.Kind() == reflect.Interface {
+		a, b = a.Elem(), b.Elem()
+		if !a.IsValid() || !b.IsValid() || a.Type() != b.Type() {
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		}
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Bool:
+		return cmp.Compare(boolInt(a.Bool()), boolInt(b.Bool()))
+	default:
+		p := &printer{opts: PrettyOptions{MaxDepth: 3}, visiting: map[visit]bool{}}
+		return strings.Compare(p.format(a, 0), p.format(b, 0))
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
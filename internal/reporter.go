@@ -0,0 +1,315 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Environment variables selecting the built-in reporters.
+const (
+	// EnvReporter is a comma-separated list of reporters used in addition
+	// to the plain text output: "github", "junit" and "tap".
+	EnvReporter = "GS_ASSERT_REPORTER"
+	// EnvReportDir is the directory of the report files. By default, the
+	// report is written to the current directory, i.e. the package directory.
+	EnvReportDir = "GS_ASSERT_REPORT_DIR"
+)
+
+// MultiReporter delivers each failure to all the reporters in order.
+type MultiReporter []Reporter
+
+// Report delivers the failure to all the reporters.
+func (m MultiReporter) Report(t TestingT, f *Failure) {
+	t.Helper()
+	for _, r := range m {
+		r.Report(t, f)
+	}
+}
+
+// NewReporter creates the reporter selected by the names, a comma-separated
+// list of "github", "junit" and "tap". The text reporter is always the last one,
+// so the test fails as usual. Unknown names are ignored. Without a directory,
+// the report files are created in the current directory, which is the package
+// directory when running `go test`. Otherwise, the files are named after the
+// test package, so that packages tested in parallel don't overwrite each other.
+func NewReporter(names string, dir string) Reporter {
+	var m MultiReporter
+	for name := range strings.SplitSeq(names, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "github":
+			m = append(m, &GitHubReporter{Out: os.Stdout, Root: os.Getenv("GITHUB_WORKSPACE")})
+		case "junit":
+			r := NewJUnitReporter("assert-report.xml")
+			r.dir, r.ext = dir, ".xml"
+			m = append(m, r)
+		case "tap":
+			r := NewTAPReporter("assert-report.tap")
+			r.dir, r.ext = dir, ".tap"
+			m = append(m, r)
+		}
+	}
+	if len(m) == 0 {
+		return TextReporter{}
+	}
+	return append(m, TextReporter{})
+}
+
+// envReporter returns the reporter selected by the environment variables.
+var envReporter = sync.OnceValue(func() Reporter {
+	return NewReporter(os.Getenv(EnvReporter), os.Getenv(EnvReportDir))
+})
+
+// GitHubReporter emits GitHub Actions workflow commands, so that the
+// failures are annotated on the changed lines of a pull request.
+// It doesn't fail the test by itself, so combine it with a TextReporter.
+type GitHubReporter struct {
+	Out  io.Writer // where the workflow commands are written
+	Root string    // the workspace root, file paths are made relative to it
+}
+
+// Report writes an `::error file=...,line=...::message` workflow command.
+func (r *GitHubReporter) Report(t TestingT, f *Failure) {
+	file := f.Location.File
+	if r.Root != "" {
+		if rel, err := filepath.Rel(r.Root, file); err == nil {
+			file = filepath.ToSlash(rel)
+		}
+	}
+	title := f.Assertion
+	if title == "" {
+		title = "Assertion failed"
+	}
+	_, _ = fmt.Fprintf(r.Out, "::error file=%s,line=%d,title=%s::%s\n",
+		escapeProperty(file), f.Location.Line, escapeProperty(title), escapeData(f.String()))
+}
+
+// escapeData escapes the message of a workflow command.
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes a property value of a workflow command.
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// testName returns the name of the test that reported the failure.
+func testName(t TestingT, f *Failure) string {
+	if name := Name(t); name != "" {
+		return name
+	}
+	name := f.Location.Function
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = name[strings.Index(name, ".")+1:]
+	return name
+}
+
+// testSuite returns the package of the test that reported the failure.
+func testSuite(f *Failure) string {
+	name := f.Location.Function
+	i := strings.LastIndex(name, "/") + 1
+	if j := strings.Index(name[i:], "."); j >= 0 {
+		return name[:i+j]
+	}
+	return name
+}
+
+// testFailures is a test with its failures, in reporting order.
+type testFailures struct {
+	suite    string
+	name     string
+	failures []*Failure
+}
+
+// fileReporter aggregates the failures per test, and writes the report file
+// when a test with failures finishes, instead of on every failure. Failures
+// reported to a test context without Cleanup are written by Flush.
+type fileReporter struct {
+	mutex   sync.Mutex
+	path    string
+	dir     string // if set, the file is named after the test package
+	ext     string
+	tests   []*testFailures
+	pending bool // whether a flush is registered as a test cleanup
+	write   func(w io.Writer, tests []*testFailures) error
+}
+
+// Report records the failure, and registers a flush of the report file
+// at the end of the test unless one is already registered.
+func (r *fileReporter) Report(t TestingT, f *Failure) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	name := testName(t, f)
+	var test *testFailures
+	for _, c := range r.tests {
+		if c.name == name {
+			test = c
+			break
+		}
+	}
+	if test == nil {
+		test = &testFailures{suite: testSuite(f), name: name}
+		r.tests = append(r.tests, test)
+	}
+	test.failures = append(test.failures, f)
+	if r.dir != "" {
+		name := strings.NewReplacer("/", "_", ".", "_").Replace(test.suite)
+		r.path, r.dir = filepath.Join(r.dir, name+r.ext), ""
+	}
+	if !r.pending {
+		r.pending = Cleanup(t, func() {
+			if err := r.Flush(); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "gs-assert: failed to write report: %v\n", err)
+			}
+		})
+	}
+}
+
+// Flush writes the report file with all the failures reported so far.
+func (r *fileReporter) Flush() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.pending = false
+	if dir := filepath.Dir(r.path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	file, err := os.Create(r.path)
+	if err != nil {
+		return err
+	}
+	if err = r.write(file, r.tests); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// JUnitReporter writes the failures per test into a JUnit XML report file.
+// It doesn't fail the test by itself, so combine it with a TextReporter.
+type JUnitReporter struct {
+	fileReporter
+}
+
+// NewJUnitReporter creates a JUnitReporter writing to the given file.
+func NewJUnitReporter(path string) *JUnitReporter {
+	return &JUnitReporter{fileReporter{path: path, write: writeJUnit}}
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Failures  []junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit writes the tests as a JUnit XML report.
+func writeJUnit(w io.Writer, tests []*testFailures) error {
+	var report junitSuites
+	for _, test := range tests {
+		var suite *junitSuite
+		for i := range report.Suites {
+			if report.Suites[i].Name == test.suite {
+				suite = &report.Suites[i]
+			}
+		}
+		if suite == nil {
+			report.Suites = append(report.Suites, junitSuite{Name: test.suite})
+			suite = &report.Suites[len(report.Suites)-1]
+		}
+		c := junitCase{Name: test.name, ClassName: test.suite}
+		for _, f := range test.failures {
+			c.Failures = append(c.Failures, junitFailure{
+				Message: f.Summary,
+				Type:    f.Assertion,
+				Text:    fmt.Sprintf("%s:%d\n%s", f.Location.File, f.Location.Line, f.String()),
+			})
+		}
+		suite.Cases = append(suite.Cases, c)
+		suite.Tests++
+		suite.Failures++
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// TAPReporter writes the failures per test into a TAP version 13 report file.
+// It doesn't fail the test by itself, so combine it with a TextReporter.
+type TAPReporter struct {
+	fileReporter
+}
+
+// NewTAPReporter creates a TAPReporter writing to the given file.
+func NewTAPReporter(path string) *TAPReporter {
+	return &TAPReporter{fileReporter{path: path, write: writeTAP}}
+}
+
+// writeTAP writes the tests as a TAP version 13 report.
+func writeTAP(w io.Writer, tests []*testFailures) error {
+	var sb strings.Builder
+	sb.WriteString("TAP version 13\n")
+	fmt.Fprintf(&sb, "1..%d\n", len(tests))
+	for i, test := range tests {
+		fmt.Fprintf(&sb, "not ok %d - %s\n", i+1, test.name)
+		sb.WriteString("  ---\n")
+		sb.WriteString("  failures:\n")
+		for _, f := range test.failures {
+			fmt.Fprintf(&sb, "    - assertion: %s\n", f.Assertion)
+			fmt.Fprintf(&sb, "      at: %s:%d\n", f.Location.File, f.Location.Line)
+			sb.WriteString("      message: |\n")
+			for line := range strings.SplitSeq(f.String(), "\n") {
+				sb.WriteString("        " + line + "\n")
+			}
+		}
+		sb.WriteString("  ...\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Callers is a fixed-size record of the call stack that created an assertion.
+type Callers [6]uintptr
+
+// modulePrefix is the import path prefix of this module, e.g. "github.com/go-spring/gs-assert/".
+var modulePrefix = func() string {
+	name := runtime.FuncForPC(reflect.ValueOf(Header).Pointer()).Name()
+	return strings.TrimSuffix(name, "internal.Header")
+}()
+
+// libraryPackages are the packages whose frames are skipped when
+// looking for the user code that created an assertion.
+var libraryPackages = map[string]bool{
+	"assert":     true,
+	"require":    true,
+	"must":       true,
+	"internal":   true,
+	"asserttest": true,
+}
+
+// isLibraryFrame reports whether the function belongs to this library.
+func isLibraryFrame(function string) bool {
+	if !strings.HasPrefix(function, modulePrefix) {
+		return false
+	}
+	pkg := strings.TrimPrefix(function, modulePrefix)
+	if i := strings.Index(pkg, "."); i >= 0 {
+		pkg = pkg[:i]
+	}
+	return libraryPackages[pkg]
+}
+
+// TypeName returns the qualified name of the named type, or of the type
+// it points to, without type arguments, e.g. "example/order.OrderAssertion".
+func TypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer && t.Name() == "" {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" {
+		return ""
+	}
+	return t.PkgPath() + "." + name
+}
+
+// isAssertionFrame reports whether the function is a method of the
+// assertion type named typ, e.g. "example/order.(*OrderAssertion).IsPaid",
+// or an assertion constructor in its package, e.g. "example/order.ThatOrder".
+func isAssertionFrame(function, typ string) bool {
+	i := strings.LastIndex(typ, ".")
+	if i < 0 || !strings.HasPrefix(function, typ[:i+1]) {
+		return false
+	}
+	name, rest := typ[i+1:], function[i+1:]
+	if strings.HasPrefix(rest, "That") {
+		return true
+	}
+	rest = strings.TrimPrefix(rest, "(*")
+	return strings.HasPrefix(rest, name+")") || strings.HasPrefix(rest, name+"[") || strings.HasPrefix(rest, name+".")
+}
+
+// Capture records the call stack of an assertion constructor.
+// The skip parameter is the number of frames to skip, with 0
+// identifying the caller of Capture.
+func Capture(skip int) (c Callers) {
+	runtime.Callers(skip+2, c[:])
+	return
+}
+
+// Caller returns the first frame of the call stack outside this library
+// and outside the functions of the assertion type named typ, if any,
+// i.e. the user code that created the assertion.
+func (c Callers) Caller(typ string) (runtime.Frame, bool) {
+	n := 0
+	for n < len(c) && c[n] != 0 {
+		n++
+	}
+	frames := runtime.CallersFrames(c[:n])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !isLibraryFrame(frame.Function) && !isAssertionFrame(frame.Function, typ) {
+			return frame, true
+		}
+		if !more {
+			return runtime.Frame{}, false
+		}
+	}
+}
+
+// Expression returns the source text of the value argument of the
+// assertion constructor call that created the assertion, e.g. "resp.Count"
+// for `assert.ThatNumber(t, resp.Count)`. It returns an empty string if the
+// source is not available or the value is a literal, which needs no label.
+// The typ parameter is the name of the assertion type, see Caller.
+func (c Callers) Expression(typ string) string {
+	frame, ok := c.Caller(typ)
+	if !ok || frame.File == "" {
+		return ""
+	}
+	src := parseSource(frame.File)
+	if src == nil {
+		return ""
+	}
+	return src.expression(frame.Line)
+}
+
+// sourceFile is a parsed Go source file.
+type sourceFile struct {
+	fset *token.FileSet
+	file *ast.File
+	data []byte
+}
+
+// sourceCache caches parsed source files by file name.
+// Files that can't be read or parsed are cached as nil.
+var sourceCache sync.Map
+
+// parseSource parses the given source file, using the cache if possible.
+func parseSource(filename string) *sourceFile {
+	if v, ok := sourceCache.Load(filename); ok {
+		return v.(*sourceFile)
+	}
+	var src *sourceFile
+	if data, err := os.ReadFile(filename); err == nil {
+		fset := token.NewFileSet()
+		if file, err := parser.ParseFile(fset, filename, data, 0); err == nil {
+			src = &sourceFile{fset: fset, file: file, data: data}
+		}
+	}
+	v, _ := sourceCache.LoadOrStore(filename, src)
+	return v.(*sourceFile)
+}
+
+// position returns the position in the file, ignoring //line directives.
+func (s *sourceFile) position(p token.Pos) token.Position {
+	return s.fset.PositionFor(p, false)
+}
+
+// expression finds the innermost `That*` call covering the given line
+// and returns the source text of its value argument. It returns an empty
+// string if several calls of the same span cover the line, e.g. two
+// assertions on one line, as the line doesn't tell which one failed.
+func (s *sourceFile) expression(line int) string {
+	var (
+		found     ast.Expr
+		span      = -1
+		ambiguous bool
+	)
+	ast.Inspect(s.file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		start, end := s.position(n.Pos()).Line, s.position(n.End()).Line
+		if line < start || line > end {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 || !strings.HasPrefix(funcName(call.Fun), "That") {
+			return true
+		}
+		if end-start == span {
+			ambiguous = true
+		}
+		if span < 0 || end-start < span {
+			// Runtime invariants take no test context: `must.That(v)`.
+			if len(call.Args) == 1 {
+				found = call.Args[0]
+			} else {
+				found = call.Args[1]
+			}
+			span = end - start
+			ambiguous = false
+		}
+		return true
+	})
+	if found == nil || ambiguous || isLiteral(found) {
+		return ""
+	}
+	text := string(s.data[s.position(found.Pos()).Offset:s.position(found.End()).Offset])
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// funcName returns the name of the called function.
+func funcName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	case *ast.IndexExpr: // explicit type argument, e.g. ThatNumber[int]
+		return funcName(f.X)
+	case *ast.IndexListExpr:
+		return funcName(f.X)
+	default:
+		return ""
+	}
+}
+
+// isLiteral reports whether the expression is made of literals only,
+// such as `5`, `-1`, `"abc"`, `[]int{1, 2}`, `nil` or `int8(5)`.
+func isLiteral(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit, *ast.CompositeLit, *ast.FuncLit:
+		return true
+	case *ast.Ident:
+		return e.Name == "nil" || e.Name == "true" || e.Name == "false"
+	case *ast.ParenExpr:
+		return isLiteral(e.X)
+	case *ast.UnaryExpr:
+		return isLiteral(e.X)
+	case *ast.BinaryExpr:
+		return isLiteral(e.X) && isLiteral(e.Y)
+	case *ast.CallExpr:
+		if len(e.Args) == 0 {
+			return false
+		}
+		for _, arg := range e.Args {
+			if !isLiteral(arg) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
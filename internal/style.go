@@ -0,0 +1,277 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Environment variables controlling the text output of failures.
+const (
+	// EnvColor selects whether failures are colored: "auto" (default),
+	// "always" or "never". In auto mode, colors are used if the standard
+	// output is a terminal and the NO_COLOR environment variable is not set.
+	EnvColor = "GS_ASSERT_COLOR"
+	// EnvLayout selects the layout of the actual and expected values:
+	// "stacked" (default) or "side-by-side".
+	EnvLayout = "GS_ASSERT_LAYOUT"
+)
+
+// ANSI escape sequences used to color failures.
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiRed       = "\x1b[31m"
+	ansiGreen     = "\x1b[32m"
+	ansiRedDiff   = "\x1b[1;4;31m"
+	ansiGreenDiff = "\x1b[1;4;32m"
+)
+
+// Style controls how a failure is rendered as text.
+type Style struct {
+	Color      bool // colors actual values red and expected values green, highlighting their differences
+	SideBySide bool // prints actual and expected values side by side if they fit in Width
+	Width      int  // width of the terminal, 120 columns if not set
+}
+
+// NewStyle creates the style selected by the environment variables, read by getenv.
+// The terminal width is read from the COLUMNS environment variable.
+func NewStyle(getenv func(string) string, terminal bool) Style {
+	var s Style
+	switch strings.ToLower(getenv(EnvColor)) {
+	case "always":
+		s.Color = true
+	case "never":
+	default:
+		s.Color = terminal && getenv("NO_COLOR") == "" && getenv("TERM") != "dumb"
+	}
+	s.SideBySide = strings.ToLower(getenv(EnvLayout)) == "side-by-side"
+	s.Width, _ = strconv.Atoi(getenv("COLUMNS"))
+	return s
+}
+
+// isTerminal reports whether the file is a terminal.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Render renders the failure as text in the given style.
+func (f *Failure) Render(s Style) string {
+	var sb strings.Builder
+	if header := Header(f.Label, f.Context); header != "" {
+		sb.WriteString(s.paint(ansiBold, header))
+	}
+	sb.WriteString(f.Summary)
+	actualName := fieldName(f.Language, "actual")
+	expectedName := fieldName(f.Language, "expected")
+	width := 8
+	for _, d := range f.Details {
+		width = max(width, displayWidth(fieldName(f.Language, d.Name)))
+	}
+	switch {
+	case f.Actual != "" && f.Expected != "":
+		actual, expected := strings.Split(f.Actual, "\n"), strings.Split(f.Expected, "\n")
+		if s.SideBySide && s.fits(width, actual, expected) {
+			s.writeSideBySide(&sb, width, actualName, actual, expectedName, expected)
+		} else {
+			a, e := s.highlight(actual, expected)
+			writeField(&sb, width, actualName, a)
+			writeField(&sb, width, expectedName, e)
+		}
+	case f.Actual != "":
+		writeField(&sb, width, actualName, s.paint(ansiRed, f.Actual))
+	case f.Expected != "":
+		writeField(&sb, width, expectedName, s.paint(ansiGreen, f.Expected))
+	}
+	for _, d := range f.Details {
+		writeField(&sb, width, fieldName(f.Language, d.Name), d.Value)
+	}
+	writeField(&sb, width, fieldName(f.Language, "diff"), f.Diff)
+	writeField(&sb, width, fieldName(f.Language, "message"), f.Message)
+	return sb.String()
+}
+
+// writeField writes a named value aligned on the colon, indenting its
+// continuation lines to the column of the first line.
+func writeField(sb *strings.Builder, width int, name, value string) {
+	if value == "" {
+		return
+	}
+	sb.WriteString("\n")
+	sb.WriteString(fieldLabel(width, name))
+	sb.WriteString(strings.ReplaceAll(value, "\n", "\n"+strings.Repeat(" ", width+2)))
+}
+
+// fieldLabel returns the name followed by a colon, right-aligned to the
+// width, at least 8, of the longest name of the failure, so that the values
+// of all fields start at the same column.
+func fieldLabel(width int, name string) string {
+	return strings.Repeat(" ", max(width-displayWidth(name), 0)) + name + ": "
+}
+
+// paint wraps the text in the given ANSI color if colors are enabled.
+func (s Style) paint(color, text string) string {
+	if !s.Color || text == "" {
+		return text
+	}
+	return color + text + ansiReset
+}
+
+// highlight colors the actual and expected values line by line,
+// emphasizing the segments where the lines differ.
+func (s Style) highlight(actual, expected []string) (string, string) {
+	if !s.Color {
+		return strings.Join(actual, "\n"), strings.Join(expected, "\n")
+	}
+	a := make([]string, len(actual))
+	e := make([]string, len(expected))
+	for i := range max(len(a), len(e)) {
+		switch {
+		case i >= len(a):
+			e[i] = s.paint(ansiGreenDiff, expected[i])
+		case i >= len(e):
+			a[i] = s.paint(ansiRedDiff, actual[i])
+		default:
+			a[i], e[i] = s.highlightLine(actual[i], expected[i])
+		}
+	}
+	return strings.Join(a, "\n"), strings.Join(e, "\n")
+}
+
+// highlightLine colors a pair of lines, emphasizing the segments between
+// their common prefix and common suffix.
+func (s Style) highlightLine(a, e string) (string, string) {
+	prefix := commonPrefix(a, e)
+	suffix := commonSuffix(a[prefix:], e[prefix:])
+	paint := func(line, color, diffColor string) string {
+		mid := line[prefix : len(line)-suffix]
+		return s.paint(color, line[:prefix]) + s.paint(diffColor, mid) + s.paint(color, line[len(line)-suffix:])
+	}
+	return paint(a, ansiRed, ansiRedDiff), paint(e, ansiGreen, ansiGreenDiff)
+}
+
+// commonPrefix returns the length in bytes of the common prefix
+// of the strings, without splitting a UTF-8 sequence.
+func commonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) {
+		r1, size := utf8.DecodeRuneInString(a[n:])
+		r2, _ := utf8.DecodeRuneInString(b[n:])
+		if r1 != r2 {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// commonSuffix returns the length in bytes of the common suffix
+// of the strings, without splitting a UTF-8 sequence.
+func commonSuffix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) {
+		r1, size := utf8.DecodeLastRuneInString(a[:len(a)-n])
+		r2, _ := utf8.DecodeLastRuneInString(b[:len(b)-n])
+		if r1 != r2 {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// fits reports whether the values can be printed side by side,
+// with labels of the given width.
+func (s Style) fits(label int, actual, expected []string) bool {
+	width := s.Width
+	if width <= 0 {
+		width = 120
+	}
+	return label+2+maxWidth(actual)+3+label+2+maxWidth(expected) <= width
+}
+
+// writeSideBySide writes the actual and expected values in two columns.
+func (s Style) writeSideBySide(sb *strings.Builder, width int, actualName string, actual []string, expectedName string, expected []string) {
+	column := maxWidth(actual)
+	indent := strings.Repeat(" ", width+2)
+	for i := range max(len(actual), len(expected)) {
+		var a, e string
+		switch {
+		case i >= len(actual):
+			e = s.paint(ansiGreenDiff, expected[i])
+		case i >= len(expected):
+			a = s.paint(ansiRedDiff, actual[i])
+		default:
+			a, e = s.highlightLine(actual[i], expected[i])
+		}
+		padding := column
+		if i < len(actual) {
+			padding -= displayWidth(actual[i])
+		}
+		if i == 0 {
+			sb.WriteString("\n" + fieldLabel(width, actualName))
+		} else {
+			sb.WriteString("\n" + indent)
+		}
+		sb.WriteString(a + strings.Repeat(" ", padding))
+		if i == 0 {
+			sb.WriteString(" | " + fieldLabel(width, expectedName))
+		} else {
+			sb.WriteString(" | " + indent)
+		}
+		sb.WriteString(e)
+	}
+}
+
+// maxWidth returns the maximum display width of the lines.
+func maxWidth(lines []string) int {
+	n := 0
+	for _, line := range lines {
+		n = max(n, displayWidth(line))
+	}
+	return n
+}
+
+// displayWidth returns the number of terminal columns taken by the text,
+// counting East Asian wide characters as two columns.
+func displayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		if isWide(r) {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// isWide reports whether the rune is an East Asian wide character.
+func isWide(r rune) bool {
+	return r >= 0x1100 && (r <= 0x115f || // Hangul Jamo
+		(r >= 0x2e80 && r <= 0xa4cf && r != 0x303f) || // CJK ... Yi
+		(r >= 0xac00 && r <= 0xd7a3) || // Hangul Syllables
+		(r >= 0xf900 && r <= 0xfaff) || // CJK Compatibility Ideographs
+		(r >= 0xfe30 && r <= 0xfe4f) || // CJK Compatibility Forms
+		(r >= 0xff00 && r <= 0xff60) || // Fullwidth Forms
+		(r >= 0xffe0 && r <= 0xffe6) ||
+		(r >= 0x20000 && r <= 0x3fffd))
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package internal
+
+// Optional capabilities of a TestingT beyond the minimum interface.
+// They are detected by interface assertions, so that test contexts
+// implementing only Helper, Error and Fatal keep working.
+type (
+	cleanupT interface{ Cleanup(func()) }
+	nameT    interface{ Name() string }
+	logT     interface {
+		Logf(format string, args ...any)
+	}
+	failedT interface{ Failed() bool }
+)
+
+// Cleanup registers fn to be called when the test finishes, and reports
+// whether t supports it.
+func Cleanup(t TestingT, fn func()) bool {
+	if c, ok := t.(cleanupT); ok {
+		c.Cleanup(fn)
+		return true
+	}
+	return false
+}
+
+// Name returns the name of the test, or "" if t doesn't support it.
+func Name(t TestingT) string {
+	if n, ok := t.(nameT); ok {
+		return n.Name()
+	}
+	return ""
+}
+
+// Logf logs the formatted message to the test, and reports whether
+// t supports it.
+func Logf(t TestingT, format string, args ...any) bool {
+	if l, ok := t.(logT); ok {
+		l.Logf(format, args...)
+		return true
+	}
+	return false
+}
+
+// Failed reports whether the test has failed, false if t doesn't support it.
+func Failed(t TestingT) bool {
+	if f, ok := t.(failedT); ok {
+		return f.Failed()
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package must provides the fluent assertions of the `assert` package for
+// runtime invariants in non-test code, where there is no *testing.T.
+// By default, a violated invariant panics with the formatted failure message.
+// If a logger is set by SetLogger, or the package is built with the `must_log`
+// build tag, the failure is logged instead and execution continues.
+package must
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+)
+
+var logger atomic.Pointer[slog.Logger]
+
+// SetLogger sets the logger used to report violated invariants.
+// Passing nil restores the default behavior selected by the build tags.
+func SetLogger(l *slog.Logger) {
+	logger.Store(l)
+}
+
+// invariant adapts runtime invariants to the internal.TestingT interface.
+type invariant struct{}
+
+// Helper does nothing as there is no test context.
+func (invariant) Helper() {}
+
+// Error reports a violated invariant.
+func (invariant) Error(args ...any) {
+	report(fmt.Sprint(args...))
+}
+
+// Fatal reports a violated invariant.
+func (invariant) Fatal(args ...any) {
+	report(fmt.Sprint(args...))
+}
+
+// Report reports a violated invariant as text, instead of the reporters of
+// tests, so that production code never writes test reports.
+func (invariant) Report(t internal.TestingT, f *internal.Failure) {
+	internal.TextReporter{}.Report(t, f)
+}
+
+// report logs the failure message if a logger is available, otherwise panics.
+func report(msg string) {
+	l := logger.Load()
+	if l == nil && logByDefault {
+		l = slog.Default()
+	}
+	if l == nil {
+		panic(msg)
+	}
+	l.Error(msg)
+}
+
+// checker is the test context shared by all runtime invariants.
+var checker internal.TestingT = invariant{}
+
+// Panic asserts that fn panics and the panic message matches expr.
+func Panic(fn func(), expr string, msg ...any) {
+	internal.Panic(checker, false, fn, expr, msg...)
+}
+
+// That creates an Assertion for the given value v.
+func That(v any) *assert.Assertion {
+	return assert.That(checker, v)
+}
+
+// ThatString returns a StringAssertion for the given string value.
+func ThatString(v string) *assert.StringAssertion {
+	return assert.ThatString(checker, v)
+}
+
+// ThatNumber returns a NumberAssertion for the given number value.
+func ThatNumber[T assert.Number](v T) *assert.NumberAssertion[T] {
+	return assert.ThatNumber[T](checker, v)
+}
+
+// ThatAnyNumber returns an AnyNumberAssertion for the given number value.
+func ThatAnyNumber[T assert.Number](v T) *assert.AnyNumberAssertion[T] {
+	return assert.ThatAnyNumber[T](checker, v)
+}
+
+// ThatBigInt returns a BigNumberAssertion for the given big.Int value.
+func ThatBigInt(v *big.Int) *assert.BigNumberAssertion[*big.Int] {
+	return assert.ThatBigInt(checker, v)
+}
+
+// ThatBigFloat returns a BigNumberAssertion for the given big.Float value.
+func ThatBigFloat(v *big.Float) *assert.BigNumberAssertion[*big.Float] {
+	return assert.ThatBigFloat(checker, v)
+}
+
+// ThatBigRat returns a BigNumberAssertion for the given big.Rat value.
+func ThatBigRat(v *big.Rat) *assert.BigNumberAssertion[*big.Rat] {
+	return assert.ThatBigRat(checker, v)
+}
+
+// ThatComplex returns a ComplexAssertion for the given complex value.
+func ThatComplex[T assert.Complex](v T) *assert.ComplexAssertion[T] {
+	return assert.ThatComplex[T](checker, v)
+}
+
+// ThatError returns a new ErrorAssertion for the given error value.
+func ThatError(v error) *assert.ErrorAssertion {
+	return assert.ThatError(checker, v)
+}
+
+// ThatOrdered returns an OrderedAssertion for the given ordered value.
+func ThatOrdered[T cmp.Ordered](v T) *assert.OrderedAssertion[T] {
+	return assert.ThatOrdered[T](checker, v)
+}
+
+// ThatComparable returns an OrderedAssertion for the given value ordered by compare.
+func ThatComparable[T any](v T, compare func(a, b T) int) *assert.OrderedAssertion[T] {
+	return assert.ThatComparable[T](checker, v, compare)
+}
+
+// ThatSlice returns a SliceAssertion for the given slice value.
+func ThatSlice[T comparable](v []T) *assert.SliceAssertion[T] {
+	return assert.ThatSlice[T](checker, v)
+}
+
+// ThatSamples returns a SamplesAssertion for the given samples.
+func ThatSamples[T assert.Number](v []T) *assert.SamplesAssertion[T] {
+	return assert.ThatSamples[T](checker, v)
+}
+
+// ThatVector returns a VectorAssertion for the given vector.
+func ThatVector[T assert.Number](v []T) *assert.VectorAssertion[T] {
+	return assert.ThatVector[T](checker, v)
+}
+
+// ThatMatrix returns a MatrixAssertion for the given matrix.
+func ThatMatrix[T assert.Number](v [][]T) *assert.MatrixAssertion[T] {
+	return assert.ThatMatrix[T](checker, v)
+}
+
+// ThatMap returns a MapAssertion for the given map value.
+func ThatMap[K, V comparable](v map[K]V) *assert.MapAssertion[K, V] {
+	return assert.ThatMap[K, V](checker, v)
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 The Go-Spring Authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package must_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+	"github.com/go-spring/gs-assert/must"
+)
+
+// TestMain keeps the failure messages checked by the tests independent of
+// the GS_ASSERT_* environment variables.
+func TestMain(m *testing.M) {
+	internal.PinTestEnv()
+	os.Exit(m.Run())
+}
+
+func TestPanicMode(t *testing.T) {
+	must.That(1).Equal(1)
+	must.ThatString("abc").HasPrefix("a")
+	must.ThatNumber(5).GreaterThan(0)
+	must.ThatError(nil).Nil()
+	must.ThatSlice([]int{1, 2}).Contains(2)
+	must.ThatMap(map[string]int{"a": 1}).ContainsKey("a")
+	must.Panic(func() { panic("boom") }, "boom")
+
+	assert.Panic(t, func() {
+		must.ThatNumber(-1).Positive("order total")
+	}, `Assertion failed: expected number to be positive, but it is -1
+ message: order total`)
+
+	total := -1
+	assert.Panic(t, func() {
+		must.ThatNumber(total).Positive()
+	}, `Assertion failed: total: expected number to be positive, but it is -1`)
+
+	assert.Panic(t, func() {
+		must.Panic(func() {}, "boom")
+	}, "Assertion failed: did not panic")
+}
+
+func TestLogMode(t *testing.T) {
+	var buf bytes.Buffer
+	must.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+	defer must.SetLogger(nil)
+
+	must.ThatString("abc").Equal("abc")
+	assert.ThatString(t, buf.String()).Equal("")
+
+	must.ThatString("abc").Equal("abd").HasSuffix("d")
+	assert.ThatString(t, buf.String()).Equal(`level=ERROR msg="Assertion failed: expected strings to be equal, but they are not\n  actual: \"abc\"\nexpected: \"abd\""
+level=ERROR msg="Assertion failed: expected string to end with the specified suffix, but it does not\n  actual: \"abc\"\n  suffix: \"d\""
+`)
+}
+
+func TestReporter(t *testing.T) {
+	var reported []*assert.Failure
+	assert.SetReporter(assert.ReporterFunc(func(t assert.TestingT, f *assert.Failure) {
+		reported = append(reported, f)
+		assert.TextReporter{}.Report(t, f)
+	}))
+	defer assert.SetReporter(nil)
+
+	assert.Panic(t, func() {
+		must.ThatNumber(-1).Positive()
+	}, `Assertion failed: expected number to be positive, but it is -1`)
+	assert.Panic(t, func() {
+		must.Panic(func() {}, "boom")
+	}, "Assertion failed: did not panic")
+	assert.ThatSlice(t, reported).Empty()
+}
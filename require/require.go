@@ -19,17 +19,28 @@
 package require
 
 import (
+	"cmp"
+	"math/big"
+
 	"github.com/go-spring/gs-assert/assert"
 	"github.com/go-spring/gs-assert/internal"
 )
 
 // Panic asserts that fn panics and the panic message matches expr.
 // It reports an error if fn does not panic or if the recovered message does not satisfy expr.
-func Panic(t internal.TestingT, fn func(), expr string, msg ...string) {
+func Panic(t internal.TestingT, fn func(), expr string, msg ...any) {
 	t.Helper()
 	internal.Panic(t, true, fn, expr, msg...)
 }
 
+// Of switches an assertion of any type to stop the test on failure,
+// including user-defined assertion types that embed assert.AssertionBase:
+//
+//	require.Of(ThatOrder(t, order)).IsPaid()
+func Of[A interface{ Require() A }](a A) A {
+	return a.Require()
+}
+
 // That creates an Assertion for the given value v and test context t.
 func That(t internal.TestingT, v any) *assert.Assertion {
 	return assert.That(t, v).Require()
@@ -45,16 +56,66 @@ func ThatNumber[T assert.Number](t internal.TestingT, v T) *assert.NumberAsserti
 	return assert.ThatNumber[T](t, v).Require()
 }
 
+// ThatAnyNumber returns an AnyNumberAssertion for the given testing object and number value.
+func ThatAnyNumber[T assert.Number](t internal.TestingT, v T) *assert.AnyNumberAssertion[T] {
+	return assert.ThatAnyNumber[T](t, v).Require()
+}
+
+// ThatBigInt returns a BigNumberAssertion for the given testing object and big.Int value.
+func ThatBigInt(t internal.TestingT, v *big.Int) *assert.BigNumberAssertion[*big.Int] {
+	return assert.ThatBigInt(t, v).Require()
+}
+
+// ThatBigFloat returns a BigNumberAssertion for the given testing object and big.Float value.
+func ThatBigFloat(t internal.TestingT, v *big.Float) *assert.BigNumberAssertion[*big.Float] {
+	return assert.ThatBigFloat(t, v).Require()
+}
+
+// ThatBigRat returns a BigNumberAssertion for the given testing object and big.Rat value.
+func ThatBigRat(t internal.TestingT, v *big.Rat) *assert.BigNumberAssertion[*big.Rat] {
+	return assert.ThatBigRat(t, v).Require()
+}
+
+// ThatComplex returns a ComplexAssertion for the given testing object and complex value.
+func ThatComplex[T assert.Complex](t internal.TestingT, v T) *assert.ComplexAssertion[T] {
+	return assert.ThatComplex[T](t, v).Require()
+}
+
 // ThatError returns a new ErrorAssertion for the given error value.
 func ThatError(t internal.TestingT, v error) *assert.ErrorAssertion {
 	return assert.ThatError(t, v).Require()
 }
 
+// ThatOrdered returns an OrderedAssertion for the given testing object and ordered value.
+func ThatOrdered[T cmp.Ordered](t internal.TestingT, v T) *assert.OrderedAssertion[T] {
+	return assert.ThatOrdered[T](t, v).Require()
+}
+
+// ThatComparable returns an OrderedAssertion for the given testing object and value ordered by compare.
+func ThatComparable[T any](t internal.TestingT, v T, compare func(a, b T) int) *assert.OrderedAssertion[T] {
+	return assert.ThatComparable[T](t, v, compare).Require()
+}
+
 // ThatSlice returns a SliceAssertion for the given testing object and slice value.
 func ThatSlice[T comparable](t internal.TestingT, v []T) *assert.SliceAssertion[T] {
 	return assert.ThatSlice[T](t, v).Require()
 }
 
+// ThatSamples returns a SamplesAssertion for the given testing object and samples.
+func ThatSamples[T assert.Number](t internal.TestingT, v []T) *assert.SamplesAssertion[T] {
+	return assert.ThatSamples[T](t, v).Require()
+}
+
+// ThatVector returns a VectorAssertion for the given testing object and vector.
+func ThatVector[T assert.Number](t internal.TestingT, v []T) *assert.VectorAssertion[T] {
+	return assert.ThatVector[T](t, v).Require()
+}
+
+// ThatMatrix returns a MatrixAssertion for the given testing object and matrix.
+func ThatMatrix[T assert.Number](t internal.TestingT, v [][]T) *assert.MatrixAssertion[T] {
+	return assert.ThatMatrix[T](t, v).Require()
+}
+
 // ThatMap returns a MapAssertion for the given testing object and map value.
 func ThatMap[K, V comparable](t internal.TestingT, v map[K]V) *assert.MapAssertion[K, V] {
 	return assert.ThatMap[K, V](t, v).Require()
@@ -14,4 +14,61 @@
  * limitations under the License.
  */
 
-package require
+package require_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/go-spring/gs-assert/assert"
+	"github.com/go-spring/gs-assert/internal"
+	"github.com/go-spring/gs-assert/require"
+)
+
+// TestMain keeps the failure messages checked by the tests independent of
+// the GS_ASSERT_* environment variables.
+func TestMain(m *testing.M) {
+	internal.PinTestEnv()
+	os.Exit(m.Run())
+}
+
+// durationAssertion is a user-defined assertion type.
+type durationAssertion struct {
+	assert.AssertionBase[*durationAssertion]
+	v int64
+}
+
+func ThatDuration(t assert.TestingT, v int64) *durationAssertion {
+	a := &durationAssertion{v: v}
+	a.AssertionBase = assert.NewAssertionBase(t, a)
+	return a
+}
+
+// AtMost asserts that the duration is at most the given number of seconds.
+func (a *durationAssertion) AtMost(seconds int64, msg ...any) *durationAssertion {
+	a.Helper()
+	if a.v > seconds {
+		a.Fail(assert.Failure{
+			Summary: fmt.Sprintf("expected duration to be at most %ds, but it is %ds", seconds, a.v),
+		}, msg...)
+	}
+	return a
+}
+
+func TestOf(t *testing.T) {
+	m := new(internal.MockTestingT)
+
+	d := ThatDuration(m, 5)
+	assert.That(t, require.Of(d)).Same(d)
+
+	m.Reset()
+	elapsed := int64(5)
+	require.Of(ThatDuration(m, elapsed)).AtMost(3)
+	assert.ThatString(t, m.String()).Equal("fatal# Assertion failed: elapsed: expected duration to be at most 3s, but it is 5s")
+
+	m.Reset()
+
+	require.Of(assert.ThatNumber(m, -1)).GreaterThan(0)
+	assert.ThatString(t, m.String()).Equal("fatal# Assertion failed: expected number to be greater than 0, but it is -1")
+}